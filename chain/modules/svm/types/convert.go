@@ -1,17 +1,30 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/gagliardetto/solana-go"
 )
 
+// ToCosmosMsg converts tx, panicking on malformed transactions, see NewMsgTransaction.
 func ToCosmosMsg(signers []string, computeBudget uint64, tx *solana.Transaction) *MsgTransaction {
+	msg, err := NewMsgTransaction(signers, computeBudget, tx)
+	if err != nil {
+		panic(err)
+	}
+	return msg
+}
+
+// NewMsgTransaction converts a solana transaction to a MsgTransaction signed by the
+// cosmos accounts linked to its signers.
+func NewMsgTransaction(signers []string, computeBudget uint64, tx *solana.Transaction) (*MsgTransaction, error) {
 	pubkeys := []string{}
 	for _, p := range tx.Message.AccountKeys {
 		pubkeys = append(pubkeys, p.String())
 	}
 
 	ixs := []*Instruction{}
-	for _, ix := range tx.Message.Instructions {
+	for i, ix := range tx.Message.Instructions {
 		fluxInstr := &Instruction{
 			ProgramIndex: []uint32{uint32(ix.ProgramIDIndex)},
 			Data:         ix.Data,
@@ -19,7 +32,7 @@ func ToCosmosMsg(signers []string, computeBudget uint64, tx *solana.Transaction)
 
 		accounts, err := ix.ResolveInstructionAccounts(&tx.Message)
 		if err != nil {
-			panic(err)
+			return nil, fmt.Errorf("resolve accounts of instruction %d err: %w", i, err)
 		}
 
 		for _, a := range accounts {
@@ -39,7 +52,7 @@ func ToCosmosMsg(signers []string, computeBudget uint64, tx *solana.Transaction)
 		Accounts:      pubkeys,
 		Instructions:  ixs,
 		ComputeBudget: computeBudget,
-	}
+	}, nil
 }
 
 func positionOf(a solana.PublicKey, s []solana.PublicKey) int {
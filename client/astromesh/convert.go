@@ -0,0 +1,293 @@
+package astromesh
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrZeroAmount   = errors.New("amount rounds to zero on destination plane")
+	ErrInexact      = errors.New("amount is not exactly representable on destination plane")
+	ErrInvalidScale = errors.New("invalid decimals")
+)
+
+const defaultLinkCacheTTL = 10 * time.Minute
+
+type RoundingMode int
+
+const (
+	// RoundDown truncates towards zero, the remainder stays on the source plane
+	RoundDown RoundingMode = iota
+	// RoundUp rounds away from zero, the destination gets more than the source amount is worth
+	RoundUp
+	// RoundHalfUp rounds to the nearest value, halves away from zero
+	RoundHalfUp
+	// RoundExact refuses amounts that lose precision with ErrInexact
+	RoundExact
+)
+
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundDown:
+		return "down"
+	case RoundUp:
+		return "up"
+	case RoundHalfUp:
+		return "half_up"
+	case RoundExact:
+		return "exact"
+	}
+	return fmt.Sprintf("RoundingMode(%d)", int(m))
+}
+
+// DenomLinkResolver resolves denom links, implemented by chain.ChainClient.
+type DenomLinkResolver interface {
+	GetDenomLink(ctx context.Context, srcPlane astromeshtypes.Plane, denom string, dstPlane astromeshtypes.Plane) (*astromeshtypes.QueryDenomLinkResponse, error)
+}
+
+// DenomLink is a resolved link of a denom between two planes.
+type DenomLink struct {
+	SrcPlane    astromeshtypes.Plane
+	DstPlane    astromeshtypes.Plane
+	SrcDenom    string
+	DstDenom    string
+	SrcDecimals int32
+	DstDecimals int32
+}
+
+// Conversion is the result of scaling Amount from the source to the destination plane.
+type Conversion struct {
+	Link     *DenomLink
+	Rounding RoundingMode
+	// Amount in source decimals
+	Amount sdkmath.Int
+	// Converted amount in destination decimals
+	Converted sdkmath.Int
+	// Remainder in source decimals is Amount minus the source value of Converted:
+	// the dust left behind when positive, the amount added by rounding up when negative
+	Remainder sdkmath.Int
+}
+
+// IsExact reports whether the conversion lost no precision.
+func (c *Conversion) IsExact() bool {
+	return c.Remainder.IsZero()
+}
+
+type linkKey struct {
+	src, dst astromeshtypes.Plane
+	denom    string
+}
+
+type cachedLink struct {
+	link      *DenomLink
+	expiresAt time.Time
+}
+
+// Converter converts amounts between planes, caching resolved denom links.
+type Converter struct {
+	resolver DenomLinkResolver
+	ttl      time.Duration
+
+	mux   sync.RWMutex
+	links map[linkKey]cachedLink
+}
+
+// NewConverter creates a converter caching links for ttl, 0 uses 10 minutes.
+func NewConverter(resolver DenomLinkResolver, ttl time.Duration) *Converter {
+	if ttl <= 0 {
+		ttl = defaultLinkCacheTTL
+	}
+
+	return &Converter{
+		resolver: resolver,
+		ttl:      ttl,
+		links:    map[linkKey]cachedLink{},
+	}
+}
+
+// Link resolves the link of denom from srcPlane to dstPlane.
+func (c *Converter) Link(ctx context.Context, srcPlane astromeshtypes.Plane, denom string, dstPlane astromeshtypes.Plane) (*DenomLink, error) {
+	key := linkKey{src: srcPlane, dst: dstPlane, denom: denom}
+
+	c.mux.RLock()
+	cached, ok := c.links[key]
+	c.mux.RUnlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.link, nil
+	}
+
+	res, err := c.resolver.GetDenomLink(ctx, srcPlane, denom, dstPlane)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get denom link of %s from %s to %s", denom, srcPlane, dstPlane)
+	}
+
+	link := &DenomLink{
+		SrcPlane:    srcPlane,
+		DstPlane:    dstPlane,
+		SrcDenom:    denom,
+		DstDenom:    res.DstAddr,
+		SrcDecimals: res.SrcDecimals,
+		DstDecimals: res.DstDecimals,
+	}
+	if err := validateDecimals(link.SrcDecimals, link.DstDecimals); err != nil {
+		return nil, err
+	}
+
+	c.mux.Lock()
+	c.links[key] = cachedLink{link: link, expiresAt: time.Now().Add(c.ttl)}
+	c.mux.Unlock()
+
+	return link, nil
+}
+
+// IsLinkNotFound reports whether err of Link is the denom having no link to the
+// destination plane. Module errors reach the client as codes.Unknown unless the chain
+// maps them, so the error text is matched as well, like GetSVMAccountLink does.
+func IsLinkNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return status.Code(err) == codes.NotFound || strings.Contains(err.Error(), "link not found")
+}
+
+// Invalidate drops all cached links.
+func (c *Converter) Invalidate() {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.links = map[linkKey]cachedLink{}
+}
+
+// Convert scales amount of denom on srcPlane into its linked denom on dstPlane.
+func (c *Converter) Convert(
+	ctx context.Context,
+	srcPlane astromeshtypes.Plane, denom string, dstPlane astromeshtypes.Plane,
+	amount sdkmath.Int, mode RoundingMode,
+) (*Conversion, error) {
+	link, err := c.Link(ctx, srcPlane, denom, dstPlane)
+	if err != nil {
+		return nil, err
+	}
+
+	converted, remainder, err := Scale(amount, link.SrcDecimals, link.DstDecimals, mode)
+	if err != nil {
+		return nil, errors.Wrapf(err, "convert %s%s from %s to %s", amount, denom, srcPlane, dstPlane)
+	}
+
+	return &Conversion{
+		Link:      link,
+		Rounding:  mode,
+		Amount:    amount,
+		Converted: converted,
+		Remainder: remainder,
+	}, nil
+}
+
+// CheckTransfer previews what an MsgAstroTransfer delivers on the destination plane.
+// Transfers truncate, so the conversion rounds down; it fails with ErrZeroAmount when
+// nothing would arrive, and with ErrInexact when dust would be left behind unless
+// allowDust is set.
+func (c *Converter) CheckTransfer(ctx context.Context, msg *astromeshtypes.MsgAstroTransfer, allowDust bool) (*Conversion, error) {
+	conv, err := c.Convert(ctx, msg.SrcPlane, msg.Coin.Denom, msg.DstPlane, msg.Coin.Amount, RoundDown)
+	if err != nil {
+		return nil, err
+	}
+
+	if conv.Converted.IsZero() && !conv.Amount.IsZero() {
+		return conv, errors.Wrapf(ErrZeroAmount, "%s%s is below the smallest unit of %s", msg.Coin.Amount, msg.Coin.Denom, conv.Link.DstDenom)
+	}
+
+	if !allowDust && !conv.IsExact() {
+		return conv, errors.Wrapf(ErrInexact, "transfer leaves %s%s of dust", conv.Remainder, msg.Coin.Denom)
+	}
+
+	return conv, nil
+}
+
+// TransferableAmount rounds amount down to a value that converts without dust.
+func (c *Converter) TransferableAmount(ctx context.Context, srcPlane astromeshtypes.Plane, coin sdk.Coin, dstPlane astromeshtypes.Plane) (sdk.Coin, error) {
+	conv, err := c.Convert(ctx, srcPlane, coin.Denom, dstPlane, coin.Amount, RoundDown)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(coin.Denom, coin.Amount.Sub(conv.Remainder)), nil
+}
+
+// Scale converts amount from srcDecimals to dstDecimals with mode, returning the converted
+// amount and the remainder in source decimals (see Conversion.Remainder).
+func Scale(amount sdkmath.Int, srcDecimals, dstDecimals int32, mode RoundingMode) (converted, remainder sdkmath.Int, err error) {
+	if err := validateDecimals(srcDecimals, dstDecimals); err != nil {
+		return sdkmath.Int{}, sdkmath.Int{}, err
+	}
+
+	if amount.IsNil() {
+		return sdkmath.Int{}, sdkmath.Int{}, fmt.Errorf("nil amount")
+	}
+
+	if dstDecimals >= srcDecimals {
+		scaled := new(big.Int).Mul(amount.BigInt(), pow10(dstDecimals-srcDecimals).BigInt())
+		if scaled.BitLen() > sdkmath.MaxBitLen {
+			return sdkmath.Int{}, sdkmath.Int{}, fmt.Errorf("%s overflows at %d decimals", amount, dstDecimals)
+		}
+		return sdkmath.NewIntFromBigInt(scaled), sdkmath.ZeroInt(), nil
+	}
+
+	factor := pow10(srcDecimals - dstDecimals)
+	quo, rem := new(big.Int).QuoRem(amount.BigInt(), factor.BigInt(), new(big.Int))
+	converted = sdkmath.NewIntFromBigInt(quo)
+
+	if rem.Sign() != 0 {
+		switch mode {
+		case RoundDown:
+		case RoundUp:
+			converted = converted.AddRaw(int64(rem.Sign()))
+		case RoundHalfUp:
+			twice := new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2))
+			if twice.Cmp(factor.BigInt()) >= 0 {
+				converted = converted.AddRaw(int64(rem.Sign()))
+			}
+		case RoundExact:
+			return sdkmath.Int{}, sdkmath.Int{}, errors.Wrapf(ErrInexact, "%s loses %s at %d decimals", amount, sdkmath.NewIntFromBigInt(rem), dstDecimals)
+		default:
+			return sdkmath.Int{}, sdkmath.Int{}, fmt.Errorf("unknown rounding mode %s", mode)
+		}
+	}
+
+	return converted, amount.Sub(converted.Mul(factor)), nil
+}
+
+// DefaultDecimals returns the decimals of native denoms on plane.
+func DefaultDecimals(plane astromeshtypes.Plane) int32 {
+	switch plane {
+	case astromeshtypes.Plane_EVM:
+		return int32(astromeshtypes.DefaultEvmDecimals)
+	case astromeshtypes.Plane_WASM:
+		return int32(astromeshtypes.DefaultWasmDecimals)
+	case astromeshtypes.Plane_SVM:
+		return int32(astromeshtypes.DefaultSvmDecimals)
+	}
+	return int32(astromeshtypes.DefaultCosmosDecimals)
+}
+
+func validateDecimals(decimals ...int32) error {
+	for _, d := range decimals {
+		if d < 0 || d > astromeshtypes.MaxDecimals {
+			return errors.Wrapf(ErrInvalidScale, "%d is out of [0, %d]", d, astromeshtypes.MaxDecimals)
+		}
+	}
+	return nil
+}
+
+func pow10(n int32) sdkmath.Int {
+	return sdkmath.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil))
+}
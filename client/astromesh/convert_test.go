@@ -0,0 +1,132 @@
+package astromesh
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func requireIntEqual(t *testing.T, expected, actual sdkmath.Int) {
+	t.Helper()
+	require.True(t, expected.Equal(actual), "expected %s, got %s", expected, actual)
+}
+
+func TestScale(t *testing.T) {
+	testCases := []struct {
+		name      string
+		amount    int64
+		src, dst  int32
+		mode      RoundingMode
+		converted int64
+		remainder int64
+	}{
+		{"up scale", 15, 6, 9, RoundDown, 15000, 0},
+		{"same decimals", 15, 6, 6, RoundExact, 15, 0},
+		{"exact down scale", 15000, 9, 6, RoundExact, 15, 0},
+		{"round down", 15999, 9, 6, RoundDown, 15, 999},
+		{"round up", 15001, 9, 6, RoundUp, 16, -999},
+		{"round half up below half", 15499, 9, 6, RoundHalfUp, 15, 499},
+		{"round half up at half", 15500, 9, 6, RoundHalfUp, 16, -500},
+		{"round down below unit", 999, 9, 6, RoundDown, 0, 999},
+		{"round up below unit", 1, 9, 6, RoundUp, 1, -999},
+		{"negative round down", -15999, 9, 6, RoundDown, -15, -999},
+		{"negative round up", -15001, 9, 6, RoundUp, -16, 999},
+		{"negative round half up", -15500, 9, 6, RoundHalfUp, -16, 500},
+		{"zero", 0, 18, 6, RoundExact, 0, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			converted, remainder, err := Scale(sdkmath.NewInt(tc.amount), tc.src, tc.dst, tc.mode)
+			require.NoError(t, err)
+			requireIntEqual(t, sdkmath.NewInt(tc.converted), converted)
+			requireIntEqual(t, sdkmath.NewInt(tc.remainder), remainder)
+
+			// the remainder is what the converted amount misses in source decimals
+			back, _, err := Scale(converted, tc.dst, tc.src, RoundExact)
+			require.NoError(t, err)
+			requireIntEqual(t, sdkmath.NewInt(tc.amount), back.Add(remainder))
+		})
+	}
+}
+
+func TestScaleErrors(t *testing.T) {
+	_, _, err := Scale(sdkmath.NewInt(15001), 9, 6, RoundExact)
+	require.ErrorIs(t, err, ErrInexact)
+
+	_, _, err = Scale(sdkmath.NewInt(1), -1, 6, RoundDown)
+	require.ErrorIs(t, err, ErrInvalidScale)
+
+	_, _, err = Scale(sdkmath.NewInt(1), 6, astromeshtypes.MaxDecimals+1, RoundDown)
+	require.ErrorIs(t, err, ErrInvalidScale)
+
+	_, _, err = Scale(sdkmath.Int{}, 6, 9, RoundDown)
+	require.ErrorContains(t, err, "nil amount")
+
+	_, _, err = Scale(sdkmath.NewInt(15001), 9, 6, RoundingMode(42))
+	require.ErrorContains(t, err, "unknown rounding mode")
+
+	max := sdkmath.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 250))
+	_, _, err = Scale(max, 0, 18, RoundDown)
+	require.ErrorContains(t, err, "overflows")
+}
+
+type fakeLinkResolver map[string]*astromeshtypes.QueryDenomLinkResponse
+
+func (r fakeLinkResolver) GetDenomLink(_ context.Context, _ astromeshtypes.Plane, denom string, _ astromeshtypes.Plane) (*astromeshtypes.QueryDenomLinkResponse, error) {
+	res, ok := r[denom]
+	if !ok {
+		// a module error the chain does not map to a grpc code
+		return nil, status.Errorf(codes.Unknown, "denom link not found: %s", denom)
+	}
+	return res, nil
+}
+
+func TestConverterTransfer(t *testing.T) {
+	converter := NewConverter(fakeLinkResolver{
+		"lux": {DstAddr: "0xlux", SrcDecimals: 18, DstDecimals: 9},
+	}, 0)
+	ctx := context.Background()
+
+	msg := &astromeshtypes.MsgAstroTransfer{
+		SrcPlane: astromeshtypes.Plane_COSMOS,
+		DstPlane: astromeshtypes.Plane_SVM,
+		Coin:     sdk.NewInt64Coin("lux", 1_500_000_001),
+	}
+	conv, err := converter.CheckTransfer(ctx, msg, false)
+	require.ErrorIs(t, err, ErrInexact)
+	requireIntEqual(t, sdkmath.NewInt(1), conv.Converted)
+	requireIntEqual(t, sdkmath.NewInt(500_000_001), conv.Remainder)
+
+	_, err = converter.CheckTransfer(ctx, msg, true)
+	require.NoError(t, err)
+
+	msg.Coin = sdk.NewInt64Coin("lux", 999_999_999)
+	_, err = converter.CheckTransfer(ctx, msg, true)
+	require.ErrorIs(t, err, ErrZeroAmount)
+
+	coin, err := converter.TransferableAmount(ctx, astromeshtypes.Plane_COSMOS, sdk.NewInt64Coin("lux", 1_500_000_001), astromeshtypes.Plane_SVM)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("lux", 1_000_000_000), coin)
+
+	_, err = converter.Link(ctx, astromeshtypes.Plane_COSMOS, "unknown", astromeshtypes.Plane_SVM)
+	require.True(t, IsLinkNotFound(err))
+}
+
+func TestIsLinkNotFound(t *testing.T) {
+	require.False(t, IsLinkNotFound(nil))
+	require.True(t, IsLinkNotFound(status.Error(codes.NotFound, "not found")))
+	// unmapped module errors keep their text under codes.Unknown
+	require.True(t, IsLinkNotFound(status.Error(codes.Unknown, "denom link not found: unknown request")))
+	require.True(t, IsLinkNotFound(errors.Wrap(status.Error(codes.Unknown, "denom link not found"), "failed to get denom link")))
+	require.False(t, IsLinkNotFound(status.Error(codes.Unavailable, "connection refused")))
+	require.False(t, IsLinkNotFound(status.Error(codes.Unknown, "invalid plane")))
+}
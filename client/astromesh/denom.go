@@ -0,0 +1,252 @@
+package astromesh
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	"github.com/FluxNFTLabs/sdk-go/client/svm"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+)
+
+// DenomClient is the part of chain.ChainClient used by DenomManager.
+type DenomClient interface {
+	DenomLinkResolver
+	FromAddress() sdk.AccAddress
+	SyncBroadcastMsg(msgs ...sdk.Msg) (*txtypes.BroadcastTxResponse, error)
+}
+
+// DenomSpec describes a bank denom created through astromesh.
+type DenomSpec struct {
+	// Subdenom is appended to the creator prefix, the base denom is astromesh/<creator>/<subdenom>
+	Subdenom    string
+	Name        string
+	Symbol      string
+	Description string
+	// Display denom unit, required when Decimals is not 0
+	Display  string
+	Decimals uint32
+	URI      string
+	URIHash  string
+}
+
+// BankDenomBase returns the base denom created by creator for subdenom.
+func BankDenomBase(creator sdk.AccAddress, subdenom string) string {
+	return "astromesh/" + creator.String() + "/" + subdenom
+}
+
+// Metadata builds the bank metadata of the denom created by creator.
+func (s DenomSpec) Metadata(creator sdk.AccAddress) (*banktypes.Metadata, error) {
+	if strings.TrimSpace(s.Subdenom) == "" {
+		return nil, fmt.Errorf("empty subdenom")
+	}
+
+	base := BankDenomBase(creator, s.Subdenom)
+	metadata := &banktypes.Metadata{
+		Description: s.Description,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: base, Exponent: 0},
+		},
+		Base:    base,
+		Display: base,
+		Name:    s.Name,
+		Symbol:  s.Symbol,
+		URI:     s.URI,
+		URIHash: s.URIHash,
+	}
+
+	if s.Decimals > 0 {
+		if s.Display == "" {
+			return nil, fmt.Errorf("display denom is required for %d decimals", s.Decimals)
+		}
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    s.Display,
+			Exponent: s.Decimals,
+		})
+		metadata.Display = s.Display
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid metadata of %s: %w", base, err)
+	}
+	return metadata, nil
+}
+
+// LinkedDenom is a bank denom with its counterparts on the VM planes.
+type LinkedDenom struct {
+	Denom    string
+	Metadata *banktypes.Metadata
+	// TxHash of the creation tx, empty when the denom was only resolved
+	TxHash string
+	// Links to the planes the denom is linked to
+	Links map[astromeshtypes.Plane]*DenomLink
+}
+
+// EvmContract returns the ERC20 contract of the denom.
+func (d *LinkedDenom) EvmContract() (ethcommon.Address, bool) {
+	link, ok := d.Links[astromeshtypes.Plane_EVM]
+	if !ok {
+		return ethcommon.Address{}, false
+	}
+	return ethcommon.HexToAddress(link.DstDenom), true
+}
+
+// SvmMint returns the token 2022 mint of the denom, minted by astromeshtypes.SvmMintAuthority.
+func (d *LinkedDenom) SvmMint() (solana.PublicKey, bool) {
+	link, ok := d.Links[astromeshtypes.Plane_SVM]
+	if !ok {
+		return solana.PublicKey{}, false
+	}
+
+	bz, err := hex.DecodeString(link.DstDenom)
+	if err != nil || len(bz) != solana.PublicKeyLength {
+		return solana.PublicKey{}, false
+	}
+	return solana.PublicKeyFromBytes(bz), true
+}
+
+// SvmAta returns the associated token account of owner for the SVM mint, false when
+// the denom has no SVM mint or no address can be derived.
+func (d *LinkedDenom) SvmAta(owner solana.PublicKey) (solana.PublicKey, bool) {
+	mint, ok := d.SvmMint()
+	if !ok {
+		return solana.PublicKey{}, false
+	}
+
+	ata, err := svm.FindAta(owner, svmtypes.SplToken2022ProgramId, mint, svmtypes.AssociatedTokenProgramId)
+	if err != nil {
+		return solana.PublicKey{}, false
+	}
+	return ata, true
+}
+
+// WasmContract returns the CW20 contract of the denom.
+func (d *LinkedDenom) WasmContract() (string, bool) {
+	link, ok := d.Links[astromeshtypes.Plane_WASM]
+	if !ok {
+		return "", false
+	}
+	return link.DstDenom, true
+}
+
+// DenomManager creates astromesh bank denoms and moves funds between the sender's
+// cosmos and VM accounts.
+type DenomManager struct {
+	client    DenomClient
+	converter *Converter
+}
+
+func NewDenomManager(client DenomClient) *DenomManager {
+	return &DenomManager{
+		client:    client,
+		converter: NewConverter(client, 0),
+	}
+}
+
+// Create creates the denom of spec, optionally minted to initialMints, waits for the
+// tx inclusion and resolves the denom on every VM plane.
+func (m *DenomManager) Create(ctx context.Context, spec DenomSpec, minter string, initialMints ...*astromeshtypes.InitialMint) (*LinkedDenom, error) {
+	sender := m.client.FromAddress()
+	metadata, err := spec.Metadata(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mint := range initialMints {
+		if _, err := sdk.AccAddressFromBech32(mint.Address); err != nil {
+			return nil, fmt.Errorf("invalid initial mint address %s: %w", mint.Address, err)
+		}
+		if mint.Amount.IsNil() || !mint.Amount.IsPositive() {
+			return nil, fmt.Errorf("initial mint amount of %s must be positive", mint.Address)
+		}
+	}
+
+	msg := &astromeshtypes.MsgCreateBankDenom{
+		Sender:       sender.String(),
+		Metadata:     metadata,
+		Minter:       minter,
+		InitialMints: initialMints,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("validate create bank denom err: %w", err)
+	}
+
+	res, err := m.client.SyncBroadcastMsg(msg)
+	if err != nil {
+		return nil, fmt.Errorf("create bank denom %s err: %w", metadata.Base, err)
+	}
+	if res.TxResponse.Code != 0 {
+		return nil, fmt.Errorf("create bank denom %s failed with code %d: %s", metadata.Base, res.TxResponse.Code, res.TxResponse.RawLog)
+	}
+
+	denom, err := m.Resolve(ctx, metadata.Base)
+	if err != nil {
+		return nil, err
+	}
+	denom.Metadata = metadata
+	denom.TxHash = res.TxResponse.TxHash
+	return denom, nil
+}
+
+// Resolve looks up the counterparts of an existing bank denom, planes the denom is
+// not linked to are left out.
+func (m *DenomManager) Resolve(ctx context.Context, denom string) (*LinkedDenom, error) {
+	linked := &LinkedDenom{
+		Denom: denom,
+		Links: map[astromeshtypes.Plane]*DenomLink{},
+	}
+
+	for _, plane := range vmPlanes {
+		link, err := m.converter.Link(ctx, astromeshtypes.Plane_COSMOS, denom, plane)
+		if err != nil && !IsLinkNotFound(err) {
+			return nil, err
+		}
+		if err != nil || link.DstDenom == "" {
+			continue
+		}
+		linked.Links[plane] = link
+	}
+
+	return linked, nil
+}
+
+// Charge moves amount from the sender's bank balance to its account on plane.
+func (m *DenomManager) Charge(plane astromeshtypes.Plane, amount sdk.Coin) (*txtypes.BroadcastTxResponse, error) {
+	return m.broadcast(&astromeshtypes.MsgChargeVmAccount{
+		Sender: m.client.FromAddress().String(),
+		Plane:  plane,
+		Amount: amount,
+	})
+}
+
+// Drain moves all balances of the sender's account on plane back to its bank balance.
+func (m *DenomManager) Drain(plane astromeshtypes.Plane) (*txtypes.BroadcastTxResponse, error) {
+	return m.broadcast(&astromeshtypes.MsgDrainVmAccount{
+		Sender: m.client.FromAddress().String(),
+		Plane:  plane,
+	})
+}
+
+func (m *DenomManager) broadcast(msg sdk.Msg) (*txtypes.BroadcastTxResponse, error) {
+	if v, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := v.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("validate %T err: %w", msg, err)
+		}
+	}
+
+	res, err := m.client.SyncBroadcastMsg(msg)
+	if err != nil {
+		return nil, fmt.Errorf("broadcast %T err: %w", msg, err)
+	}
+	if res.TxResponse.Code != 0 {
+		return res, fmt.Errorf("%T failed with code %d: %s", msg, res.TxResponse.Code, res.TxResponse.RawLog)
+	}
+	return res, nil
+}
@@ -0,0 +1,386 @@
+package astromesh
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	sdkmath "cosmossdk.io/math"
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	chaintypes "github.com/FluxNFTLabs/sdk-go/chain/types"
+	"github.com/FluxNFTLabs/sdk-go/client/eventstream"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+	"golang.org/x/sync/errgroup"
+)
+
+// vmPlanes are the planes holding balances besides the cosmos bank
+var vmPlanes = []astromeshtypes.Plane{
+	astromeshtypes.Plane_EVM,
+	astromeshtypes.Plane_SVM,
+	astromeshtypes.Plane_WASM,
+}
+
+const defaultPortfolioConcurrency = 8
+
+// PortfolioQuerier is the part of chain.ChainClient used by Portfolio.
+type PortfolioQuerier interface {
+	DenomLinkResolver
+	GetSVMAccountLink(ctx context.Context, cosmosAddress sdk.AccAddress) (isLinked bool, pubkey solana.PublicKey, err error)
+	GetBankBalances(ctx context.Context, address string) (*banktypes.QueryAllBalancesResponse, error)
+}
+
+// PlaneBalance is the balance of a denom on one plane.
+type PlaneBalance struct {
+	Plane astromeshtypes.Plane
+	// Denom on this plane, e.g. the EVM contract or SVM mint address
+	Denom    string
+	Decimals int32
+	Amount   sdkmath.Int
+	// Normalized is Amount in the decimals of the cosmos denom
+	Normalized sdkmath.Int
+}
+
+// Holding is the consolidated balance of a cosmos denom across all planes.
+type Holding struct {
+	Denom string
+	// Decimals of the cosmos denom, balances are normalized to it
+	Decimals int32
+	Metadata *astromeshtypes.TokenMetadata
+	// Total is the sum of normalized balances on all planes
+	Total  sdkmath.Int
+	Planes map[astromeshtypes.Plane]*PlaneBalance
+}
+
+func (h *Holding) recompute() {
+	total := sdkmath.ZeroInt()
+	for _, b := range h.Planes {
+		total = total.Add(b.Normalized)
+	}
+	h.Total = total
+}
+
+// PortfolioView is the consolidated view of a user's assets. It is safe for
+// concurrent use, Apply keeps it up to date from balance update events.
+type PortfolioView struct {
+	CosmosAddress sdk.AccAddress
+	EvmAddress    ethcommon.Address
+	SvmAddress    solana.PublicKey
+	SvmLinked     bool
+
+	mux      sync.RWMutex
+	holdings map[string]*Holding
+	// byPlaneDenom maps a plane denom to its cosmos denom
+	byPlaneDenom map[astromeshtypes.Plane]map[string]string
+	metadata     map[string]*astromeshtypes.TokenMetadata
+}
+
+// Holdings returns copies of all holdings sorted by denom.
+func (v *PortfolioView) Holdings() []Holding {
+	v.mux.RLock()
+	defer v.mux.RUnlock()
+
+	res := make([]Holding, 0, len(v.holdings))
+	for _, h := range v.holdings {
+		c := *h
+		c.Planes = make(map[astromeshtypes.Plane]*PlaneBalance, len(h.Planes))
+		for plane, b := range h.Planes {
+			bc := *b
+			c.Planes[plane] = &bc
+		}
+		res = append(res, c)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Denom < res[j].Denom })
+	return res
+}
+
+// Holding returns a copy of the holding of a cosmos denom.
+func (v *PortfolioView) Holding(denom string) (Holding, bool) {
+	for _, h := range v.Holdings() {
+		if h.Denom == denom {
+			return h, true
+		}
+	}
+	return Holding{}, false
+}
+
+// accountOf returns the raw account of the user on plane as found in balance events.
+func (v *PortfolioView) accountOf(plane astromeshtypes.Plane) []byte {
+	switch plane {
+	case astromeshtypes.Plane_EVM:
+		return v.EvmAddress.Bytes()
+	case astromeshtypes.Plane_SVM:
+		if !v.SvmLinked {
+			return nil
+		}
+		return v.SvmAddress.Bytes()
+	}
+	return v.CosmosAddress.Bytes()
+}
+
+// Apply updates the view from a BalanceUpdateEvent, it returns whether any balance of
+// the user changed. Denoms unknown to the view are ignored.
+func (v *PortfolioView) Apply(ev *astromeshtypes.BalanceUpdateEvent) bool {
+	acc := v.accountOf(ev.Plane)
+	if len(acc) == 0 {
+		return false
+	}
+
+	v.mux.Lock()
+	defer v.mux.Unlock()
+
+	changed := false
+	for _, upd := range ev.Upd {
+		denom, ok := v.byPlaneDenom[ev.Plane][upd.Denom]
+		if !ok {
+			continue
+		}
+		holding := v.holdings[denom]
+		balance := holding.Planes[ev.Plane]
+
+		for _, b := range upd.Balances {
+			if !bytes.Equal(b.Acc, acc) {
+				continue
+			}
+
+			normalized, _, err := Scale(b.Balance, balance.Decimals, holding.Decimals, RoundDown)
+			if err != nil {
+				continue
+			}
+			balance.Amount = b.Balance
+			balance.Normalized = normalized
+			changed = true
+		}
+
+		if changed {
+			holding.recompute()
+		}
+	}
+
+	return changed
+}
+
+// ApplyMetadata attaches token metadata of cosmos denoms to the view.
+func (v *PortfolioView) ApplyMetadata(ev *astromeshtypes.TokenMetadataEvent) {
+	v.mux.Lock()
+	defer v.mux.Unlock()
+
+	for _, m := range ev.Metadata {
+		if m.Plane != astromeshtypes.Plane_COSMOS {
+			continue
+		}
+		v.metadata[m.Denom] = m
+		if h, ok := v.holdings[m.Denom]; ok {
+			h.Metadata = m
+		}
+	}
+}
+
+// Portfolio aggregates the balances of users across all planes.
+type Portfolio struct {
+	querier         PortfolioQuerier
+	astromeshClient astromeshtypes.QueryClient
+	converter       *Converter
+
+	mux      sync.RWMutex
+	metadata map[string]*astromeshtypes.TokenMetadata
+}
+
+func NewPortfolio(querier PortfolioQuerier, astromeshClient astromeshtypes.QueryClient) *Portfolio {
+	return &Portfolio{
+		querier:         querier,
+		astromeshClient: astromeshClient,
+		converter:       NewConverter(querier, 0),
+		metadata:        map[string]*astromeshtypes.TokenMetadata{},
+	}
+}
+
+// SetTokenMetadata registers token metadata (e.g. from the explorer) attached to
+// holdings of new views.
+func (p *Portfolio) SetTokenMetadata(metadata ...*astromeshtypes.TokenMetadata) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	for _, m := range metadata {
+		if m.Plane == astromeshtypes.Plane_COSMOS {
+			p.metadata[m.Denom] = m
+		}
+	}
+}
+
+// Get builds the portfolio of address: bank balances plus the balances of every
+// bank denom and extraDenoms on the linked VM accounts, normalized to cosmos decimals.
+// Planes a denom is not linked to are skipped.
+func (p *Portfolio) Get(ctx context.Context, address sdk.AccAddress, extraDenoms ...string) (*PortfolioView, error) {
+	view := &PortfolioView{
+		CosmosAddress: address,
+		holdings:      map[string]*Holding{},
+		byPlaneDenom:  map[astromeshtypes.Plane]map[string]string{},
+		metadata:      map[string]*astromeshtypes.TokenMetadata{},
+	}
+	for _, plane := range append([]astromeshtypes.Plane{astromeshtypes.Plane_COSMOS}, vmPlanes...) {
+		view.byPlaneDenom[plane] = map[string]string{}
+	}
+
+	evmAddr, err := chaintypes.CosmosAddressToEthAddress(address.String())
+	if err != nil {
+		return nil, err
+	}
+	view.EvmAddress = evmAddr
+
+	view.SvmLinked, view.SvmAddress, err = p.querier.GetSVMAccountLink(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("get svm account link err: %w", err)
+	}
+
+	bankRes, err := p.querier.GetBankBalances(ctx, address.String())
+	if err != nil {
+		return nil, fmt.Errorf("get bank balances err: %w", err)
+	}
+
+	p.mux.RLock()
+	for denom, m := range p.metadata {
+		view.metadata[denom] = m
+	}
+	p.mux.RUnlock()
+
+	addHolding := func(denom string, amount sdkmath.Int) {
+		if _, ok := view.holdings[denom]; ok {
+			return
+		}
+		decimals := DefaultDecimals(astromeshtypes.Plane_COSMOS)
+		if m, ok := view.metadata[denom]; ok {
+			decimals = int32(m.Decimals)
+		}
+		view.holdings[denom] = &Holding{
+			Denom:    denom,
+			Decimals: decimals,
+			Metadata: view.metadata[denom],
+			Planes: map[astromeshtypes.Plane]*PlaneBalance{
+				astromeshtypes.Plane_COSMOS: {
+					Plane:      astromeshtypes.Plane_COSMOS,
+					Denom:      denom,
+					Decimals:   decimals,
+					Amount:     amount,
+					Normalized: amount,
+				},
+			},
+		}
+		view.byPlaneDenom[astromeshtypes.Plane_COSMOS][denom] = denom
+	}
+
+	for _, coin := range bankRes.Balances {
+		addHolding(coin.Denom, coin.Amount)
+	}
+	for _, denom := range extraDenoms {
+		addHolding(denom, sdkmath.ZeroInt())
+	}
+
+	var mux sync.Mutex
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(defaultPortfolioConcurrency)
+	for denom, holding := range view.holdings {
+		for _, plane := range vmPlanes {
+			if plane == astromeshtypes.Plane_SVM && !view.SvmLinked {
+				continue
+			}
+
+			denom, holding, plane := denom, holding, plane
+			g.Go(func() error {
+				balance, link, err := p.planeBalance(gctx, view, denom, plane)
+				if err != nil || balance == nil {
+					return err
+				}
+
+				mux.Lock()
+				defer mux.Unlock()
+				holding.Decimals = link.SrcDecimals
+				holding.Planes[astromeshtypes.Plane_COSMOS].Decimals = link.SrcDecimals
+				holding.Planes[plane] = balance
+				view.byPlaneDenom[plane][balance.Denom] = denom
+				return nil
+			})
+		}
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	for _, h := range view.holdings {
+		h.recompute()
+	}
+
+	return view, nil
+}
+
+// planeBalance returns the balance of the linked denom on plane, nil if denom has no link.
+func (p *Portfolio) planeBalance(ctx context.Context, view *PortfolioView, denom string, plane astromeshtypes.Plane) (*PlaneBalance, *DenomLink, error) {
+	link, err := p.converter.Link(ctx, astromeshtypes.Plane_COSMOS, denom, plane)
+	if err != nil && !IsLinkNotFound(err) {
+		return nil, nil, err
+	}
+	if err != nil || link.DstDenom == "" {
+		// not every denom lives on every plane
+		return nil, nil, nil
+	}
+
+	res, err := p.astromeshClient.Balance(ctx, &astromeshtypes.BalanceRequest{
+		Plane:   plane.String(),
+		Denom:   link.DstDenom,
+		Address: PlaneAddress(view, plane),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("get %s balance of %s err: %w", plane, link.DstDenom, err)
+	}
+
+	amount, ok := sdkmath.NewIntFromString(res.Amount)
+	if !ok {
+		amount = sdkmath.ZeroInt()
+	}
+
+	normalized, _, err := Scale(amount, link.DstDecimals, link.SrcDecimals, RoundDown)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &PlaneBalance{
+		Plane:      plane,
+		Denom:      link.DstDenom,
+		Decimals:   link.DstDecimals,
+		Amount:     amount,
+		Normalized: normalized,
+	}, link, nil
+}
+
+// PlaneAddress formats the user's account on plane: hex on EVM, base58 on SVM and
+// bech32 on cosmos and WASM.
+func PlaneAddress(view *PortfolioView, plane astromeshtypes.Plane) string {
+	switch plane {
+	case astromeshtypes.Plane_EVM:
+		return view.EvmAddress.Hex()
+	case astromeshtypes.Plane_SVM:
+		return view.SvmAddress.String()
+	}
+	return view.CosmosAddress.String()
+}
+
+// Watch keeps view up to date from the astromesh events routed by router, calling
+// onUpdate after every change. Use the router as eventstream consumer handler.
+func Watch(router *eventstream.EventRouter, view *PortfolioView, onUpdate func(ctx context.Context, view *PortfolioView) error) {
+	eventstream.OnEvent(router, func(ctx context.Context, _ *eventstream.DecodedBlock, ev *astromeshtypes.BalanceUpdateEvent) error {
+		if view.Apply(ev) && onUpdate != nil {
+			return onUpdate(ctx, view)
+		}
+		return nil
+	})
+
+	eventstream.OnEvent(router, func(ctx context.Context, _ *eventstream.DecodedBlock, ev *astromeshtypes.TokenMetadataEvent) error {
+		view.ApplyMetadata(ev)
+		return nil
+	})
+}
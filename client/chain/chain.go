@@ -16,6 +16,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
 	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	"github.com/FluxNFTLabs/sdk-go/client/svm/preflight"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/gagliardetto/solana-go"
 	"github.com/golang/protobuf/proto"
@@ -75,6 +76,8 @@ type ChainClient interface {
 	SimulateSignedTx(txBytes []byte) (*txtypes.SimulateResponse, error)
 	QueueBroadcastMsg(msgs ...sdk.Msg) error
 
+	// Deprecated: use svm.TxPipeline Send, which signs for every linked svm signer and
+	// bounds the compute budget.
 	SyncBroadcastSvmMsg(msg *svmtypes.MsgTransaction) (*txtypes.BroadcastTxResponse, error)
 
 	GetSVMAccountLink(ctx context.Context, cosmosAddress sdk.AccAddress) (isLinked bool, pubkey solana.PublicKey, err error)
@@ -856,7 +859,16 @@ func (c *chainClient) BroadcastDone() {
 	<-c.Broadcasted
 }
 
+// SyncBroadcastSvmMsg simulates msg, sets its compute budget to twice the consumed
+// units and broadcasts it signed with the client key only.
+//
+// Deprecated: use svm.TxPipeline Send, which signs for every linked svm signer and
+// bounds the compute budget.
 func (c *chainClient) SyncBroadcastSvmMsg(msg *svmtypes.MsgTransaction) (*txtypes.BroadcastTxResponse, error) {
+	if err := preflight.NewChecker(preflight.NewQueryLinks(c.svmQueryClient)).CheckMsg(context.Background(), msg); err != nil {
+		return nil, err
+	}
+
 	c.txFactory = c.txFactory.WithSequence(c.accSeq)
 	c.txFactory = c.txFactory.WithAccountNumber(c.accNum)
 	txf, err := c.prepareFactory(c.ClientContext(), c.txFactory)
@@ -882,10 +894,13 @@ func (c *chainClient) SyncBroadcastSvmMsg(msg *svmtypes.MsgTransaction) (*txtype
 	}
 
 	// adjust msg compute budget
+	if len(simRes.Result.MsgResponses) == 0 {
+		return nil, fmt.Errorf("simulation returned no msg response")
+	}
 	var msgRes svmtypes.MsgTransactionResponse
 	err = proto.Unmarshal(simRes.Result.MsgResponses[0].Value, &msgRes)
 	if err != nil {
-		panic(err)
+		return nil, errors.Wrap(err, "failed to unmarshal svm msg response")
 	}
 	msg.ComputeBudget = msgRes.UnitConsumed * 2
 
@@ -0,0 +1,59 @@
+package common
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	DefaultBackoffMin = 500 * time.Millisecond
+	DefaultBackoffMax = 30 * time.Second
+)
+
+// Backoff is a simple exponential backoff, doubling the delay on every attempt
+// between Min and Max. It is not safe for concurrent use.
+type Backoff struct {
+	Min time.Duration
+	Max time.Duration
+
+	attempt int
+}
+
+func NewBackoff(min, max time.Duration) *Backoff {
+	if min <= 0 {
+		min = DefaultBackoffMin
+	}
+	if max < min {
+		max = min
+	}
+	return &Backoff{Min: min, Max: max}
+}
+
+// Next returns the delay for the current attempt and advances the attempt counter.
+func (b *Backoff) Next() time.Duration {
+	d := b.Min << uint(b.attempt)
+	if d <= 0 || d > b.Max {
+		d = b.Max
+	} else {
+		b.attempt++
+	}
+	return d
+}
+
+// Reset starts the next sequence of attempts from Min again.
+func (b *Backoff) Reset() {
+	b.attempt = 0
+}
+
+// Wait sleeps for the next backoff delay, returning early with ctx error if ctx is done.
+func (b *Backoff) Wait(ctx context.Context) error {
+	t := time.NewTimer(b.Next())
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -17,6 +17,13 @@ type Network struct {
 	ChainTlsCert      credentials.TransportCredentials
 	ChainId           string
 	Name              string
+
+	// IndexerGrpcEndpoint serves every indexer service unless overridden in IndexerEndpoints,
+	// only the local network sets indexer endpoints
+	IndexerGrpcEndpoint string
+	IndexerTlsCert      credentials.TransportCredentials
+	// IndexerEndpoints maps an indexer service name (explorer, fnft, bazaar...) to its own endpoint
+	IndexerEndpoints map[string]string
 }
 
 func getFileAbsPath(relativePath string) string {
@@ -33,6 +40,15 @@ func LoadNetwork(name string, node string) Network {
 			ChainGrpcEndpoint: "localhost:9900",
 			ChainId:           "flux-1",
 			Name:              "local",
+
+			IndexerGrpcEndpoint: "localhost:4444",
+			IndexerEndpoints: map[string]string{
+				"account":           "localhost:4454",
+				"fnft":              "localhost:4447",
+				"bazaar":            "localhost:4450",
+				"campclash":         "localhost:4462",
+				"campclashProvider": "localhost:4462",
+			},
 		}
 
 	case "devnet":
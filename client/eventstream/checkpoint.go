@@ -0,0 +1,95 @@
+package eventstream
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/eventstream/types"
+)
+
+// CheckpointStore persists the last height fully processed by a Consumer.
+type CheckpointStore interface {
+	// LastHeight returns the last processed height, ok is false when nothing was stored yet.
+	LastHeight() (height uint64, ok bool, err error)
+	SaveHeight(height uint64) error
+}
+
+// WALCheckpoint stores the last processed height in an eventstream WAL
+// as a big endian uint64.
+type WALCheckpoint struct {
+	wal types.WAL
+}
+
+var _ CheckpointStore = &WALCheckpoint{}
+
+func NewWALCheckpoint(wal types.WAL) *WALCheckpoint {
+	return &WALCheckpoint{wal: wal}
+}
+
+// NewFileCheckpoint creates a checkpoint store backed by types.SimpleFileWAL.
+func NewFileCheckpoint(name string) (*WALCheckpoint, error) {
+	wal, err := types.NewSimpleFileWAL(name)
+	if err != nil {
+		return nil, fmt.Errorf("open checkpoint wal err: %w", err)
+	}
+
+	return NewWALCheckpoint(wal), nil
+}
+
+func (c *WALCheckpoint) LastHeight() (uint64, bool, error) {
+	bz, err := c.wal.Read(0)
+	if err != nil {
+		return 0, false, fmt.Errorf("read checkpoint err: %w", err)
+	}
+
+	if len(bz) == 0 {
+		return 0, false, nil
+	}
+
+	if len(bz) != 8 {
+		return 0, false, fmt.Errorf("corrupted checkpoint: expected 8 bytes, got %d", len(bz))
+	}
+
+	return binary.BigEndian.Uint64(bz), true, nil
+}
+
+func (c *WALCheckpoint) SaveHeight(height uint64) error {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, height)
+	if err := c.wal.Write(height, bz); err != nil {
+		return err
+	}
+
+	return c.wal.Prune(height)
+}
+
+func (c *WALCheckpoint) Close() {
+	c.wal.Close()
+}
+
+// MemoryCheckpoint keeps the last processed height in memory only.
+type MemoryCheckpoint struct {
+	mux    sync.Mutex
+	height uint64
+	ok     bool
+}
+
+var _ CheckpointStore = &MemoryCheckpoint{}
+
+func NewMemoryCheckpoint() *MemoryCheckpoint {
+	return &MemoryCheckpoint{}
+}
+
+func (c *MemoryCheckpoint) LastHeight() (uint64, bool, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.height, c.ok, nil
+}
+
+func (c *MemoryCheckpoint) SaveHeight(height uint64) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.height, c.ok = height, true
+	return nil
+}
@@ -0,0 +1,57 @@
+package eventstream
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/eventstream/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileCheckpoint(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "checkpoint")
+
+	checkpoint, err := NewFileCheckpoint(name)
+	require.NoError(t, err)
+	_, ok, err := checkpoint.LastHeight()
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, checkpoint.SaveHeight(41))
+	require.NoError(t, checkpoint.SaveHeight(42))
+	checkpoint.Close()
+
+	// a restarted process resumes from the last saved height
+	checkpoint, err = NewFileCheckpoint(name)
+	require.NoError(t, err)
+	defer checkpoint.Close()
+	height, ok, err := checkpoint.LastHeight()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(42), height)
+}
+
+func TestFileCheckpointCorrupted(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "checkpoint")
+	require.NoError(t, os.WriteFile(name, []byte{1, 2, 3}, 0o644))
+
+	checkpoint, err := NewFileCheckpoint(name)
+	require.NoError(t, err)
+	defer checkpoint.Close()
+	_, _, err = checkpoint.LastHeight()
+	require.ErrorContains(t, err, "corrupted checkpoint")
+}
+
+func TestWALCheckpoint(t *testing.T) {
+	checkpoint := NewWALCheckpoint(types.NewMockWAL())
+	_, ok, err := checkpoint.LastHeight()
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, checkpoint.SaveHeight(1<<40))
+	height, ok, err := checkpoint.LastHeight()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(1<<40), height)
+}
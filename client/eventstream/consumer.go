@@ -0,0 +1,278 @@
+package eventstream
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/eventstream/types"
+	"github.com/FluxNFTLabs/sdk-go/client/common"
+	log "github.com/InjectiveLabs/suplog"
+	"github.com/pkg/errors"
+)
+
+// Handler processes the events of one block. Returning an error makes the
+// consumer retry the same block until it succeeds or the context is done.
+type Handler func(ctx context.Context, res *types.EventsResponse) error
+
+type ConsumerOptions struct {
+	// StartHeight is used when the checkpoint store is empty, 0 starts from the current tip.
+	StartHeight uint64
+	Modules     []string
+	TmQueries   []string
+
+	BackoffMin time.Duration
+	BackoffMax time.Duration
+	// MaxHandlerRetries limits handler retries per block, 0 retries forever.
+	MaxHandlerRetries int
+}
+
+type ConsumerOption func(opts *ConsumerOptions) error
+
+func DefaultConsumerOptions() *ConsumerOptions {
+	return &ConsumerOptions{
+		BackoffMin: common.DefaultBackoffMin,
+		BackoffMax: common.DefaultBackoffMax,
+	}
+}
+
+func OptionStartHeight(height uint64) ConsumerOption {
+	return func(opts *ConsumerOptions) error {
+		opts.StartHeight = height
+		return nil
+	}
+}
+
+func OptionModules(modules ...string) ConsumerOption {
+	return func(opts *ConsumerOptions) error {
+		opts.Modules = modules
+		return nil
+	}
+}
+
+func OptionTmQueries(queries ...string) ConsumerOption {
+	return func(opts *ConsumerOptions) error {
+		for _, q := range queries {
+			switch q {
+			case TmQueryBlock, TmQueryBlockResults, TmQueryValidators:
+			default:
+				return fmt.Errorf("unsupported tm query: %s", q)
+			}
+		}
+		opts.TmQueries = queries
+		return nil
+	}
+}
+
+func OptionBackoff(min, max time.Duration) ConsumerOption {
+	return func(opts *ConsumerOptions) error {
+		if min <= 0 || max < min {
+			return fmt.Errorf("invalid backoff range: [%s, %s]", min, max)
+		}
+		opts.BackoffMin, opts.BackoffMax = min, max
+		return nil
+	}
+}
+
+func OptionMaxHandlerRetries(retries int) ConsumerOption {
+	return func(opts *ConsumerOptions) error {
+		if retries < 0 {
+			return fmt.Errorf("negative handler retries: %d", retries)
+		}
+		opts.MaxHandlerRetries = retries
+		return nil
+	}
+}
+
+const (
+	TmQueryBlock        = "block"
+	TmQueryBlockResults = "block_results"
+	TmQueryValidators   = "validators"
+)
+
+var ErrHandlerRetriesExceeded = errors.New("handler retries exceeded")
+
+// Consumer streams events from the eventstream service and delivers every block
+// to a handler exactly in height order, at least once. Progress is stored in a
+// CheckpointStore after each successful delivery so a restarted consumer resumes
+// from the block following the last processed one. Disconnects are retried with
+// backoff and missing heights are backfilled with GetEvents.
+type Consumer struct {
+	client     types.QueryClient
+	checkpoint CheckpointStore
+	handler    Handler
+	opts       *ConsumerOptions
+	logger     log.Logger
+
+	// nextHeight is the next height to deliver, 0 means not known yet (start from tip)
+	nextHeight uint64
+}
+
+func NewConsumer(
+	client types.QueryClient,
+	checkpoint CheckpointStore,
+	handler Handler,
+	options ...ConsumerOption,
+) (*Consumer, error) {
+	opts := DefaultConsumerOptions()
+	for _, opt := range options {
+		if err := opt(opts); err != nil {
+			err = errors.Wrap(err, "error in consumer option")
+			return nil, err
+		}
+	}
+
+	if checkpoint == nil {
+		checkpoint = NewMemoryCheckpoint()
+	}
+
+	return &Consumer{
+		client:     client,
+		checkpoint: checkpoint,
+		handler:    handler,
+		opts:       opts,
+		logger: log.WithFields(log.Fields{
+			"module": "sdk-go",
+			"svc":    "eventConsumer",
+		}),
+	}, nil
+}
+
+// NextHeight returns the next height the consumer is going to deliver, 0 when
+// the consumer has not started yet and follows the chain tip.
+func (c *Consumer) NextHeight() uint64 {
+	return c.nextHeight
+}
+
+// Run consumes events until ctx is done or the handler exhausts its retries.
+func (c *Consumer) Run(ctx context.Context) error {
+	lastHeight, ok, err := c.checkpoint.LastHeight()
+	if err != nil {
+		return errors.Wrap(err, "failed to load checkpoint")
+	}
+
+	if ok {
+		c.nextHeight = lastHeight + 1
+	} else {
+		c.nextHeight = c.opts.StartHeight
+	}
+
+	backoff := common.NewBackoff(c.opts.BackoffMin, c.opts.BackoffMax)
+	for {
+		delivered, err := c.consumeStream(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if errors.Is(err, ErrHandlerRetriesExceeded) {
+			return err
+		}
+
+		if delivered {
+			backoff.Reset()
+		}
+
+		c.logger.WithError(err).WithField("height", c.nextHeight).Warningln("event stream interrupted, reconnecting")
+		if err := backoff.Wait(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// consumeStream opens one stream and delivers blocks until the stream breaks.
+// delivered reports whether at least one block was processed on this stream.
+func (c *Consumer) consumeStream(ctx context.Context) (delivered bool, err error) {
+	streamCtx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+
+	stream, err := c.client.StreamEvents(streamCtx, c.request(c.nextHeight))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to open event stream")
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			return delivered, errors.Wrap(err, "failed to receive events")
+		}
+
+		if c.nextHeight != 0 && res.Height < c.nextHeight {
+			// duplicated block after reconnection, already processed
+			continue
+		}
+
+		if c.nextHeight != 0 && res.Height > c.nextHeight {
+			if err := c.backfill(ctx, res.Height); err != nil {
+				return delivered, err
+			}
+		}
+
+		if err := c.deliver(ctx, res); err != nil {
+			return delivered, err
+		}
+		delivered = true
+	}
+}
+
+// backfill fetches and delivers every height in [nextHeight, toHeight).
+func (c *Consumer) backfill(ctx context.Context, toHeight uint64) error {
+	c.logger.WithFields(log.Fields{
+		"from": c.nextHeight,
+		"to":   toHeight - 1,
+	}).Infoln("height gap detected, backfilling")
+
+	for c.nextHeight < toHeight {
+		res, err := c.client.GetEvents(ctx, c.request(c.nextHeight))
+		if err != nil {
+			return errors.Wrapf(err, "failed to backfill height %d", c.nextHeight)
+		}
+
+		if res.Height != c.nextHeight {
+			return fmt.Errorf("backfill height mismatch: requested %d, got %d", c.nextHeight, res.Height)
+		}
+
+		if err := c.deliver(ctx, res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliver runs the handler for res until it succeeds, then stores the checkpoint.
+func (c *Consumer) deliver(ctx context.Context, res *types.EventsResponse) error {
+	backoff := common.NewBackoff(c.opts.BackoffMin, c.opts.BackoffMax)
+	for attempt := 1; ; attempt++ {
+		err := c.handler(ctx, res)
+		if err == nil {
+			break
+		}
+
+		if c.opts.MaxHandlerRetries > 0 && attempt > c.opts.MaxHandlerRetries {
+			return errors.Wrapf(ErrHandlerRetriesExceeded, "height %d: %s", res.Height, err.Error())
+		}
+
+		c.logger.WithError(err).WithFields(log.Fields{
+			"height":  res.Height,
+			"attempt": attempt,
+		}).Warningln("event handler failed, retrying")
+		if err := backoff.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := c.checkpoint.SaveHeight(res.Height); err != nil {
+		return errors.Wrapf(err, "failed to save checkpoint at height %d", res.Height)
+	}
+
+	c.nextHeight = res.Height + 1
+	return nil
+}
+
+func (c *Consumer) request(height uint64) *types.EventsRequest {
+	return &types.EventsRequest{
+		Height:    height,
+		Modules:   c.opts.Modules,
+		TmQueries: c.opts.TmQueries,
+	}
+}
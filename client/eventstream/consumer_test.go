@@ -0,0 +1,120 @@
+package eventstream
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/eventstream/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testBlocks(from, to uint64) []*types.EventsResponse {
+	var blocks []*types.EventsResponse
+	for h := from; h <= to; h++ {
+		blocks = append(blocks, &types.EventsResponse{Height: h, Time: h})
+	}
+	return blocks
+}
+
+// runConsumer consumes the replay of blocks until lastHeight is delivered, returning
+// the delivered heights.
+func runConsumer(t *testing.T, server *ReplayServer, checkpoint CheckpointStore, lastHeight uint64, options ...ConsumerOption) []uint64 {
+	conn, stop, err := server.Listen()
+	require.NoError(t, err)
+	defer stop()
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelFn()
+
+	var heights []uint64
+	handler := func(_ context.Context, res *types.EventsResponse) error {
+		heights = append(heights, res.Height)
+		if res.Height == lastHeight {
+			cancelFn()
+		}
+		return nil
+	}
+
+	options = append(options, OptionBackoff(time.Millisecond, 10*time.Millisecond))
+	consumer, err := NewConsumer(types.NewQueryClient(conn), checkpoint, handler, options...)
+	require.NoError(t, err)
+
+	err = consumer.Run(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, lastHeight+1, consumer.NextHeight())
+	return heights
+}
+
+func TestConsumerResumesFromCheckpoint(t *testing.T) {
+	server := NewReplayServer(testBlocks(1, 10), ReplayOptions{HoldOpen: true})
+	checkpoint := NewMemoryCheckpoint()
+	require.NoError(t, checkpoint.SaveHeight(4))
+
+	heights := runConsumer(t, server, checkpoint, 10, OptionStartHeight(1))
+	require.Equal(t, []uint64{5, 6, 7, 8, 9, 10}, heights)
+
+	height, ok, err := checkpoint.LastHeight()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(10), height)
+}
+
+func TestConsumerStartHeight(t *testing.T) {
+	server := NewReplayServer(testBlocks(1, 6), ReplayOptions{HoldOpen: true})
+
+	heights := runConsumer(t, server, nil, 6, OptionStartHeight(3))
+	require.Equal(t, []uint64{3, 4, 5, 6}, heights)
+}
+
+func TestConsumerRecoversFaults(t *testing.T) {
+	server := NewReplayServer(testBlocks(1, 10), ReplayOptions{
+		HoldOpen: true,
+		Faults: []Fault{
+			{Height: 3, Kind: FaultGap},
+			{Height: 4, Kind: FaultGap},
+			{Height: 5, Kind: FaultDuplicate},
+			{Height: 7, Kind: FaultDisconnect},
+		},
+	})
+
+	heights := runConsumer(t, server, NewMemoryCheckpoint(), 10, OptionStartHeight(1))
+	require.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, heights)
+}
+
+func TestConsumerHandlerRetriesExceeded(t *testing.T) {
+	server := NewReplayServer(testBlocks(1, 3), ReplayOptions{HoldOpen: true})
+	conn, stop, err := server.Listen()
+	require.NoError(t, err)
+	defer stop()
+
+	attempts := 0
+	handler := func(_ context.Context, res *types.EventsResponse) error {
+		if res.Height == 2 {
+			attempts++
+			return errors.New("handler failed")
+		}
+		return nil
+	}
+
+	checkpoint := NewMemoryCheckpoint()
+	consumer, err := NewConsumer(types.NewQueryClient(conn), checkpoint, handler,
+		OptionStartHeight(1),
+		OptionMaxHandlerRetries(2),
+		OptionBackoff(time.Millisecond, time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelFn()
+	err = consumer.Run(ctx)
+	require.ErrorIs(t, err, ErrHandlerRetriesExceeded)
+	require.Equal(t, 3, attempts)
+
+	// the failing height is not checkpointed, a restart delivers it again
+	height, ok, err := checkpoint.LastHeight()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), height)
+}
@@ -0,0 +1,291 @@
+package eventstream
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/eventstream/types"
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	evmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/evm/types"
+	interpooltypes "github.com/FluxNFTLabs/sdk-go/chain/modules/interpool/types"
+	oracletypes "github.com/FluxNFTLabs/sdk-go/chain/modules/oracle/types"
+	strategytypes "github.com/FluxNFTLabs/sdk-go/chain/modules/strategy/types"
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+)
+
+var ErrUnknownEventType = errors.New("unknown event type")
+
+// DefaultEvents lists the module events the chain emits through the eventstream.
+func DefaultEvents() []proto.Message {
+	return []proto.Message{
+		// astromesh
+		&astromeshtypes.BalanceUpdateEvent{},
+		&astromeshtypes.TokenMetadataEvent{},
+		&astromeshtypes.WasmContractEvent{},
+		// evm
+		&evmtypes.DeployEvent{},
+		&evmtypes.ExecuteEvent{},
+		&evmtypes.EmitLogEvent{},
+		// svm
+		&svmtypes.ExecuteEvent{},
+		&svmtypes.AccUpdateEvent{},
+		&svmtypes.LinkEvent{},
+		// strategy
+		&strategytypes.StrategyUpdateEvent{},
+		&strategytypes.StrategyTriggerEvent{},
+		&strategytypes.StrategyEvent{},
+		// interpool
+		&interpooltypes.InterPoolEvent{},
+		&interpooltypes.LiquidityEvent{},
+		// oracle
+		&oracletypes.PythOracleEvent{},
+		// eventstream synthetic events
+		&types.NewTxsEvent{},
+		&types.NewBlockEvent{},
+		&types.WasmEvent{},
+	}
+}
+
+// EventRegistry maps AnyEvent type urls to their concrete event types.
+type EventRegistry struct {
+	mux   sync.RWMutex
+	types map[string]reflect.Type
+}
+
+// NewEventRegistry creates a registry with all DefaultEvents registered.
+func NewEventRegistry() *EventRegistry {
+	r := &EventRegistry{types: map[string]reflect.Type{}}
+	r.Register(DefaultEvents()...)
+	return r
+}
+
+// Register adds event types to the registry, keyed by their proto message name.
+func (r *EventRegistry) Register(events ...proto.Message) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	for _, ev := range events {
+		r.types[proto.MessageName(ev)] = reflect.TypeOf(ev).Elem()
+	}
+}
+
+// Unpack decodes the payload of ev into its registered concrete type.
+func (r *EventRegistry) Unpack(ev *types.AnyEvent) (proto.Message, error) {
+	if ev.Data == nil {
+		return nil, fmt.Errorf("event of module %s has no data", ev.Module)
+	}
+
+	return r.UnpackAny(ev.Data)
+}
+
+func (r *EventRegistry) UnpackAny(data *codectypes.Any) (proto.Message, error) {
+	// eventstream strips the leading slash of type urls, accept both forms
+	name := strings.TrimPrefix(data.TypeUrl, "/")
+	r.mux.RLock()
+	t, ok := r.types[name]
+	r.mux.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, data.TypeUrl)
+	}
+
+	msg := reflect.New(t).Interface().(proto.Message)
+	if err := proto.Unmarshal(data.Value, msg); err != nil {
+		return nil, fmt.Errorf("unmarshal %s err: %w", name, err)
+	}
+
+	return msg, nil
+}
+
+// DecodedEvent is a module event with its payload unpacked.
+// Event is nil when the type url is not registered, Raw always holds the original payload.
+type DecodedEvent struct {
+	Module string
+	Event  proto.Message
+	Raw    *codectypes.Any
+}
+
+// TmData holds the decoded CometBFT results requested with EventsRequest.TmQueries.
+type TmData struct {
+	Block        *coretypes.ResultBlock
+	BlockResults *coretypes.ResultBlockResults
+	Validators   *coretypes.ResultValidators
+}
+
+// DecodedBlock is an EventsResponse with every event and tm result decoded.
+type DecodedBlock struct {
+	Height uint64
+	Time   uint64
+	Events []DecodedEvent
+	Tm     TmData
+}
+
+// Decode unpacks all events and tm results of res. Events of unknown types are
+// kept with a nil Event so callers may still inspect the raw payload.
+func (r *EventRegistry) Decode(res *types.EventsResponse) (*DecodedBlock, error) {
+	block := &DecodedBlock{
+		Height: res.Height,
+		Time:   res.Time,
+	}
+
+	for i, module := range res.Modules {
+		if i >= len(res.Events) || res.Events[i] == nil {
+			continue
+		}
+
+		for _, anyEvent := range res.Events[i].AnyEvents {
+			decoded := DecodedEvent{Module: module, Raw: anyEvent.Data}
+			ev, err := r.Unpack(anyEvent)
+			if err != nil && !errors.Is(err, ErrUnknownEventType) {
+				return nil, fmt.Errorf("height %d module %s: %w", res.Height, module, err)
+			}
+			decoded.Event = ev
+			block.Events = append(block.Events, decoded)
+		}
+	}
+
+	tm, err := DecodeTmData(res)
+	if err != nil {
+		return nil, fmt.Errorf("height %d: %w", res.Height, err)
+	}
+	block.Tm = *tm
+
+	return block, nil
+}
+
+// DecodeTmData decodes TmData entries of res according to their TmQueries.
+func DecodeTmData(res *types.EventsResponse) (*TmData, error) {
+	tm := &TmData{}
+	for i, query := range res.TmQueries {
+		if i >= len(res.TmData) {
+			return nil, fmt.Errorf("missing tm data for query %s", query)
+		}
+
+		bz := []byte(res.TmData[i])
+		var err error
+		switch query {
+		case TmQueryBlock:
+			tm.Block = &coretypes.ResultBlock{}
+			err = unmarshalTmJSON(bz, tm.Block)
+		case TmQueryBlockResults:
+			tm.BlockResults = &coretypes.ResultBlockResults{}
+			err = unmarshalTmJSON(bz, tm.BlockResults)
+		case TmQueryValidators:
+			tm.Validators = &coretypes.ResultValidators{}
+			err = unmarshalTmJSON(bz, tm.Validators)
+		default:
+			err = fmt.Errorf("unsupported tm query")
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("decode tm query %s err: %w", query, err)
+		}
+	}
+
+	return tm, nil
+}
+
+// unmarshalTmJSON accepts both the amino compatible encoding of cometbft rpc
+// (64-bit integers as strings) and plain json.
+func unmarshalTmJSON(bz []byte, v interface{}) error {
+	if err := cmtjson.Unmarshal(bz, v); err == nil {
+		return nil
+	}
+
+	return json.Unmarshal(bz, v)
+}
+
+type eventHandler func(ctx context.Context, block *DecodedBlock, ev proto.Message) error
+
+// EventRouter dispatches decoded events to handlers registered per event type.
+// Its Handle method can be used directly as a Consumer Handler.
+type EventRouter struct {
+	registry      *EventRegistry
+	handlers      map[reflect.Type][]eventHandler
+	blockHandlers []func(ctx context.Context, block *DecodedBlock) error
+	unknown       func(ctx context.Context, block *DecodedBlock, ev DecodedEvent) error
+}
+
+func NewEventRouter(registry *EventRegistry) *EventRouter {
+	if registry == nil {
+		registry = NewEventRegistry()
+	}
+
+	return &EventRouter{
+		registry: registry,
+		handlers: map[reflect.Type][]eventHandler{},
+	}
+}
+
+func (r *EventRouter) Registry() *EventRegistry {
+	return r.registry
+}
+
+// OnEvent registers a handler for events of type T, e.g.
+//
+//	eventstream.OnEvent(router, func(ctx context.Context, b *eventstream.DecodedBlock, ev *astromeshtypes.BalanceUpdateEvent) error {...})
+//
+// T is registered into the router registry if not known yet.
+func OnEvent[T proto.Message](r *EventRouter, fn func(ctx context.Context, block *DecodedBlock, ev T) error) {
+	var zero T
+	t := reflect.TypeOf(zero)
+	r.registry.Register(reflect.New(t.Elem()).Interface().(proto.Message))
+	r.handlers[t] = append(r.handlers[t], func(ctx context.Context, block *DecodedBlock, ev proto.Message) error {
+		return fn(ctx, block, ev.(T))
+	})
+}
+
+// OnBlock registers a handler called once per block after all its event handlers.
+func (r *EventRouter) OnBlock(fn func(ctx context.Context, block *DecodedBlock) error) {
+	r.blockHandlers = append(r.blockHandlers, fn)
+}
+
+// OnUnknown registers a handler for events whose type is not registered.
+func (r *EventRouter) OnUnknown(fn func(ctx context.Context, block *DecodedBlock, ev DecodedEvent) error) {
+	r.unknown = fn
+}
+
+// Handle decodes res and dispatches its events in order.
+func (r *EventRouter) Handle(ctx context.Context, res *types.EventsResponse) error {
+	block, err := r.registry.Decode(res)
+	if err != nil {
+		return err
+	}
+
+	return r.Dispatch(ctx, block)
+}
+
+// Dispatch runs the registered handlers for an already decoded block.
+func (r *EventRouter) Dispatch(ctx context.Context, block *DecodedBlock) error {
+	for _, ev := range block.Events {
+		if ev.Event == nil {
+			if r.unknown != nil {
+				if err := r.unknown(ctx, block, ev); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		for _, h := range r.handlers[reflect.TypeOf(ev.Event)] {
+			if err := h(ctx, block, ev.Event); err != nil {
+				return fmt.Errorf("handle %s err: %w", proto.MessageName(ev.Event), err)
+			}
+		}
+	}
+
+	for _, h := range r.blockHandlers {
+		if err := h(ctx, block); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
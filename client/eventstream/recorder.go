@@ -0,0 +1,107 @@
+package eventstream
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/eventstream/types"
+)
+
+// Recorder captures EventsResponse sequences to a file so they can be replayed
+// later with ReplayServer. Records are protobuf encoded, each prefixed by its
+// uvarint length.
+type Recorder struct {
+	mux sync.Mutex
+	f   *os.File
+	w   *bufio.Writer
+}
+
+func NewRecorder(path string) (*Recorder, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("open recording err: %w", err)
+	}
+
+	return &Recorder{f: f, w: bufio.NewWriter(f)}, nil
+}
+
+// Record appends res to the recording.
+func (r *Recorder) Record(res *types.EventsResponse) error {
+	bz, err := res.Marshal()
+	if err != nil {
+		return fmt.Errorf("marshal events at height %d err: %w", res.Height, err)
+	}
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	lenBz := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(lenBz, uint64(len(bz)))
+	if _, err := r.w.Write(lenBz[:n]); err != nil {
+		return err
+	}
+
+	if _, err := r.w.Write(bz); err != nil {
+		return err
+	}
+
+	return r.w.Flush()
+}
+
+// Handle records res, it can be used as a Consumer Handler or chained in one.
+func (r *Recorder) Handle(_ context.Context, res *types.EventsResponse) error {
+	return r.Record(res)
+}
+
+func (r *Recorder) Close() error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if err := r.w.Flush(); err != nil {
+		return err
+	}
+
+	return r.f.Close()
+}
+
+// LoadRecording reads all records of a recording file.
+func LoadRecording(path string) ([]*types.EventsResponse, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open recording err: %w", err)
+	}
+	defer f.Close()
+
+	return ReadRecording(f)
+}
+
+// ReadRecording reads all records written by a Recorder from rd.
+func ReadRecording(rd io.Reader) ([]*types.EventsResponse, error) {
+	br := bufio.NewReader(rd)
+	var res []*types.EventsResponse
+	for {
+		size, err := binary.ReadUvarint(br)
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read record %d size err: %w", len(res), err)
+		}
+
+		bz := make([]byte, size)
+		if _, err := io.ReadFull(br, bz); err != nil {
+			return nil, fmt.Errorf("read record %d err: %w", len(res), err)
+		}
+
+		events := &types.EventsResponse{}
+		if err := events.Unmarshal(bz); err != nil {
+			return nil, fmt.Errorf("unmarshal record %d err: %w", len(res), err)
+		}
+		res = append(res, events)
+	}
+}
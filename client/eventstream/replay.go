@@ -0,0 +1,229 @@
+package eventstream
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/eventstream/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type FaultKind int
+
+const (
+	// FaultDisconnect aborts the stream with codes.Unavailable before sending the height
+	FaultDisconnect FaultKind = iota
+	// FaultDuplicate sends the height twice
+	FaultDuplicate
+	// FaultGap skips the height on the stream, it stays available through GetEvents
+	FaultGap
+)
+
+// Fault is injected once, the first time the stream reaches Height.
+type Fault struct {
+	Height uint64
+	Kind   FaultKind
+}
+
+type ReplayOptions struct {
+	// BlockInterval is the delay between two streamed blocks, divided by Speed.
+	// Zero streams as fast as the client receives.
+	BlockInterval time.Duration
+	Speed         float64
+	// HoldOpen keeps streams open after the last recorded block until the client leaves,
+	// like a live node waiting for new blocks. Otherwise the stream ends.
+	HoldOpen bool
+	Faults   []Fault
+}
+
+// ReplayServer is an in-process eventstream QueryServer serving recorded blocks.
+type ReplayServer struct {
+	types.UnimplementedQueryServer
+
+	opts    ReplayOptions
+	blocks  []*types.EventsResponse
+	heights map[uint64]int
+
+	mux      sync.Mutex
+	injected map[Fault]bool
+}
+
+var _ types.QueryServer = &ReplayServer{}
+
+func NewReplayServer(blocks []*types.EventsResponse, opts ReplayOptions) *ReplayServer {
+	sorted := make([]*types.EventsResponse, len(blocks))
+	copy(sorted, blocks)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Height < sorted[j].Height
+	})
+
+	heights := make(map[uint64]int, len(sorted))
+	for i, b := range sorted {
+		heights[b.Height] = i
+	}
+
+	if opts.Speed <= 0 {
+		opts.Speed = 1
+	}
+
+	return &ReplayServer{
+		opts:     opts,
+		blocks:   sorted,
+		heights:  heights,
+		injected: map[Fault]bool{},
+	}
+}
+
+// NewReplayServerFromFile loads a recording made by Recorder.
+func NewReplayServerFromFile(path string, opts ReplayOptions) (*ReplayServer, error) {
+	blocks, err := LoadRecording(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReplayServer(blocks, opts), nil
+}
+
+func (s *ReplayServer) GetEvents(_ context.Context, req *types.EventsRequest) (*types.EventsResponse, error) {
+	idx, ok := s.heights[req.Height]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "height %d not recorded", req.Height)
+	}
+
+	return filterEvents(s.blocks[idx], req), nil
+}
+
+func (s *ReplayServer) StreamEvents(req *types.EventsRequest, stream types.Query_StreamEventsServer) error {
+	ctx := stream.Context()
+	start := sort.Search(len(s.blocks), func(i int) bool {
+		return s.blocks[i].Height >= req.Height
+	})
+
+	var delay time.Duration
+	if s.opts.BlockInterval > 0 {
+		delay = time.Duration(float64(s.opts.BlockInterval) / s.opts.Speed)
+	}
+
+	for i := start; i < len(s.blocks); i++ {
+		block := s.blocks[i]
+		sends := 1
+		switch fault, ok := s.takeFault(block.Height); {
+		case !ok:
+		case fault == FaultDisconnect:
+			return status.Errorf(codes.Unavailable, "injected disconnect at height %d", block.Height)
+		case fault == FaultDuplicate:
+			sends = 2
+		case fault == FaultGap:
+			sends = 0
+		}
+
+		for j := 0; j < sends; j++ {
+			if err := stream.Send(filterEvents(block, req)); err != nil {
+				return err
+			}
+		}
+
+		if delay > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+	}
+
+	if s.opts.HoldOpen {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+func (s *ReplayServer) takeFault(height uint64) (FaultKind, bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, f := range s.opts.Faults {
+		if f.Height == height && !s.injected[f] {
+			s.injected[f] = true
+			return f.Kind, true
+		}
+	}
+
+	return 0, false
+}
+
+// Listen serves the replay server on an in-memory listener and returns a connected
+// client connection. Calling stop closes both.
+func (s *ReplayServer) Listen() (conn *grpc.ClientConn, stop func(), err error) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	types.RegisterQueryServer(srv, s)
+	go srv.Serve(lis)
+
+	conn, err = grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		srv.Stop()
+		return nil, nil, fmt.Errorf("dial replay server err: %w", err)
+	}
+
+	return conn, func() {
+		conn.Close()
+		srv.Stop()
+	}, nil
+}
+
+// filterEvents keeps only the modules and tm queries requested by req,
+// an empty list keeps everything.
+func filterEvents(res *types.EventsResponse, req *types.EventsRequest) *types.EventsResponse {
+	out := &types.EventsResponse{
+		Height: res.Height,
+		Time:   res.Time,
+	}
+
+	for i, module := range res.Modules {
+		if len(req.Modules) > 0 && !containsString(req.Modules, module) {
+			continue
+		}
+		out.Modules = append(out.Modules, module)
+		if i < len(res.Events) {
+			out.Events = append(out.Events, res.Events[i])
+		}
+	}
+
+	for i, query := range res.TmQueries {
+		if len(req.TmQueries) > 0 && !containsString(req.TmQueries, query) {
+			continue
+		}
+		out.TmQueries = append(out.TmQueries, query)
+		if i < len(res.TmData) {
+			out.TmData = append(out.TmData, res.TmData[i])
+		}
+	}
+
+	return out
+}
+
+func containsString(s []string, e string) bool {
+	for _, a := range s {
+		if a == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package eventstream
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/eventstream/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func moduleBlock(height uint64) *types.EventsResponse {
+	return &types.EventsResponse{
+		Height:    height,
+		Modules:   []string{"bank", "evm"},
+		Events:    []*types.ModuleEvents{{}, {}},
+		TmQueries: []string{TmQueryBlock},
+		TmData:    []string{"{}"},
+	}
+}
+
+func TestRecordingRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording")
+	recorder, err := NewRecorder(path)
+	require.NoError(t, err)
+
+	blocks := []*types.EventsResponse{moduleBlock(1), moduleBlock(2), moduleBlock(3)}
+	for _, b := range blocks {
+		require.NoError(t, recorder.Handle(context.Background(), b))
+	}
+	require.NoError(t, recorder.Close())
+
+	loaded, err := LoadRecording(path)
+	require.NoError(t, err)
+	require.Equal(t, blocks, loaded)
+}
+
+// streamHeights reads the stream from height until the server ends it.
+func streamHeights(t *testing.T, client types.QueryClient, req *types.EventsRequest) ([]uint64, error) {
+	stream, err := client.StreamEvents(context.Background(), req)
+	require.NoError(t, err)
+
+	var heights []uint64
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return heights, nil
+		}
+		if err != nil {
+			return heights, err
+		}
+		heights = append(heights, res.Height)
+	}
+}
+
+func TestReplayServerFaults(t *testing.T) {
+	server := NewReplayServer(testBlocks(1, 6), ReplayOptions{
+		Faults: []Fault{
+			{Height: 2, Kind: FaultDuplicate},
+			{Height: 3, Kind: FaultGap},
+			{Height: 5, Kind: FaultDisconnect},
+		},
+	})
+	conn, stop, err := server.Listen()
+	require.NoError(t, err)
+	defer stop()
+	client := types.NewQueryClient(conn)
+
+	heights, err := streamHeights(t, client, &types.EventsRequest{Height: 1})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Equal(t, []uint64{1, 2, 2, 4}, heights)
+
+	// faults are injected once, the gap stays available through GetEvents
+	heights, err = streamHeights(t, client, &types.EventsRequest{Height: 3})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 4, 5, 6}, heights)
+
+	res, err := client.GetEvents(context.Background(), &types.EventsRequest{Height: 3})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Height)
+
+	_, err = client.GetEvents(context.Background(), &types.EventsRequest{Height: 7})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestReplayServerFilters(t *testing.T) {
+	server := NewReplayServer([]*types.EventsResponse{moduleBlock(1)}, ReplayOptions{})
+	res, err := server.GetEvents(context.Background(), &types.EventsRequest{
+		Height:    1,
+		Modules:   []string{"evm"},
+		TmQueries: []string{TmQueryValidators},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"evm"}, res.Modules)
+	require.Len(t, res.Events, 1)
+	require.Empty(t, res.TmQueries)
+	require.Empty(t, res.TmData)
+
+	res, err = server.GetEvents(context.Background(), &types.EventsRequest{Height: 1})
+	require.NoError(t, err)
+	require.Equal(t, moduleBlock(1), res)
+}
@@ -0,0 +1,413 @@
+package fis
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	sdkmath "cosmossdk.io/math"
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/goccy/go-json"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// BankBalanceQuery queries the bank balance of denom owned by address.
+func BankBalanceQuery(address sdk.AccAddress, denom string) *astromeshtypes.FISQueryInstruction {
+	return &astromeshtypes.FISQueryInstruction{
+		Plane:  astromeshtypes.Plane_COSMOS,
+		Action: astromeshtypes.QueryAction_COSMOS_BANK_BALANCE,
+		Input:  [][]byte{[]byte(address.String()), []byte(denom)},
+	}
+}
+
+// AstromeshBalanceQuery queries the balance of denom owned by address on every plane.
+func AstromeshBalanceQuery(address sdk.AccAddress, denom string) *astromeshtypes.FISQueryInstruction {
+	return &astromeshtypes.FISQueryInstruction{
+		Plane:  astromeshtypes.Plane_COSMOS,
+		Action: astromeshtypes.QueryAction_COSMOS_ASTROMESH_BALANCE,
+		Input:  [][]byte{[]byte(address.String()), []byte(denom)},
+	}
+}
+
+// CosmosQuery queries the grpc-gateway path of method, the full grpc method name such as
+// "/cosmos.bank.v1beta1.Query/Balance". Path parameters are filled from req and its
+// remaining fields are sent as url query, e.g. BankQuery/Balance with
+// {address, denom} becomes /cosmos/bank/v1beta1/balances/{address}/by_denom?denom=...
+func CosmosQuery(cdc codec.JSONCodec, method string, req proto.Message) (*astromeshtypes.FISQueryInstruction, error) {
+	path, err := GatewayPath(cdc, method, req)
+	if err != nil {
+		return nil, err
+	}
+	return CosmosQueryPath(path), nil
+}
+
+// CosmosQueryPath queries a prepared grpc-gateway path, e.g. /flux/interpool/v1beta1/pools/<id>.
+func CosmosQueryPath(path string) *astromeshtypes.FISQueryInstruction {
+	return &astromeshtypes.FISQueryInstruction{
+		Plane:  astromeshtypes.Plane_COSMOS,
+		Action: astromeshtypes.QueryAction_COSMOS_QUERY,
+		Input:  [][]byte{[]byte(path)},
+	}
+}
+
+// KVStoreQuery reads the raw value stored at key of a collections map of module store.
+func KVStoreQuery[K any](storeKey string, prefix collections.Prefix, keyCodec collcodec.KeyCodec[K], key K) (*astromeshtypes.FISQueryInstruction, error) {
+	bz, err := collections.EncodeKeyWithPrefix(prefix.Bytes(), keyCodec, key)
+	if err != nil {
+		return nil, fmt.Errorf("encode %s store key err: %w", storeKey, err)
+	}
+	return KVStoreRawQuery(storeKey, bz), nil
+}
+
+// KVStoreRawQuery reads the raw value stored at key of module store.
+func KVStoreRawQuery(storeKey string, key []byte) *astromeshtypes.FISQueryInstruction {
+	return &astromeshtypes.FISQueryInstruction{
+		Plane:  astromeshtypes.Plane_COSMOS,
+		Action: astromeshtypes.QueryAction_COSMOS_KVSTORE,
+		Input:  [][]byte{[]byte(storeKey), key},
+	}
+}
+
+// EventQuery selects the events of type event (e.g. evm.EventDeploy) emitted by
+// module under name in the current block, an empty name selects them all, e.g.
+// "strategy,flux.strategy.v1beta1.StrategyEvent".
+func EventQuery(module, name string, event proto.Message) *astromeshtypes.FISQueryInstruction {
+	parts := []string{module}
+	if name != "" {
+		parts = append(parts, name)
+	}
+	parts = append(parts, proto.MessageName(event))
+
+	return &astromeshtypes.FISQueryInstruction{
+		Plane:  astromeshtypes.Plane_COSMOS,
+		Action: astromeshtypes.QueryAction_COSMOS_EVENT,
+		Input:  [][]byte{[]byte(strings.Join(parts, ","))},
+	}
+}
+
+// EvmQuery calls a view method of an EVM contract.
+func EvmQuery(contract ethcommon.Address, contractABI abi.ABI, method string, args ...interface{}) (*astromeshtypes.FISQueryInstruction, error) {
+	calldata, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("pack %s calldata err: %w", method, err)
+	}
+
+	return &astromeshtypes.FISQueryInstruction{
+		Plane:   astromeshtypes.Plane_EVM,
+		Action:  astromeshtypes.QueryAction_VM_QUERY,
+		Address: contract.Bytes(),
+		Input:   [][]byte{calldata},
+	}, nil
+}
+
+// WasmQuery runs a smart query of a cosmwasm contract, msg is json encoded.
+func WasmQuery(contract sdk.AccAddress, msg interface{}) (*astromeshtypes.FISQueryInstruction, error) {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("marshal wasm query msg err: %w", err)
+	}
+
+	return &astromeshtypes.FISQueryInstruction{
+		Plane:   astromeshtypes.Plane_WASM,
+		Action:  astromeshtypes.QueryAction_VM_QUERY,
+		Address: contract.Bytes(),
+		Input:   [][]byte{bz},
+	}, nil
+}
+
+// SvmAccountsQuery reads SVM accounts, one output per account.
+func SvmAccountsQuery(accounts ...solana.PublicKey) *astromeshtypes.FISQueryInstruction {
+	input := make([][]byte, 0, len(accounts))
+	for _, acc := range accounts {
+		input = append(input, acc.Bytes())
+	}
+
+	return &astromeshtypes.FISQueryInstruction{
+		Plane:  astromeshtypes.Plane_SVM,
+		Action: astromeshtypes.QueryAction_VM_QUERY,
+		Input:  input,
+	}
+}
+
+// NewQueryRequest wraps instructions into a FISQueryRequest.
+func NewQueryRequest(instructions ...*astromeshtypes.FISQueryInstruction) *astromeshtypes.FISQueryRequest {
+	return &astromeshtypes.FISQueryRequest{Instructions: instructions}
+}
+
+// Query runs instructions and checks there is one response per instruction.
+func Query(ctx context.Context, client astromeshtypes.QueryClient, instructions ...*astromeshtypes.FISQueryInstruction) (*astromeshtypes.FISQueryResponse, error) {
+	res, err := client.FISQuery(ctx, NewQueryRequest(instructions...))
+	if err != nil {
+		return nil, fmt.Errorf("fis query err: %w", err)
+	}
+
+	if len(res.InstructionResponses) != len(instructions) {
+		return nil, fmt.Errorf("fis query returned %d responses for %d instructions", len(res.InstructionResponses), len(instructions))
+	}
+	return res, nil
+}
+
+// GatewayPath resolves the grpc-gateway GET path of a grpc query method from its
+// google.api.http annotation and fills it with req fields.
+func GatewayPath(cdc codec.JSONCodec, method string, req proto.Message) (string, error) {
+	methodDesc, err := findMethod(method)
+	if err != nil {
+		return "", err
+	}
+
+	rule, ok := protov2.GetExtension(methodDesc.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil || rule.GetGet() == "" {
+		return "", fmt.Errorf("method %s has no http get annotation", method)
+	}
+
+	bz, err := cdc.MarshalJSON(req)
+	if err != nil {
+		return "", fmt.Errorf("marshal %T err: %w", req, err)
+	}
+
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return "", fmt.Errorf("unmarshal %T fields err: %w", req, err)
+	}
+
+	var sb strings.Builder
+	pattern := rule.GetGet()
+	used := map[string]bool{}
+	for {
+		start := strings.IndexByte(pattern, '{')
+		if start < 0 {
+			sb.WriteString(pattern)
+			break
+		}
+		end := strings.IndexByte(pattern[start:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("malformed http path %s", rule.GetGet())
+		}
+		end += start
+
+		// {name} or {name=pattern/**}
+		name := strings.SplitN(pattern[start+1:end], "=", 2)[0]
+		value, ok := lookupField(fields, name)
+		if !ok {
+			return "", fmt.Errorf("missing path parameter %s of %s", name, method)
+		}
+		used[strings.SplitN(name, ".", 2)[0]] = true
+
+		sb.WriteString(pattern[:start])
+		sb.WriteString(value)
+		pattern = pattern[end+1:]
+	}
+
+	query := url.Values{}
+	for name, v := range fields {
+		if used[name] {
+			continue
+		}
+		switch v := v.(type) {
+		case string:
+			if v != "" {
+				query.Set(name, v)
+			}
+		case bool, float64:
+			query.Set(name, fmt.Sprint(v))
+		}
+	}
+
+	if len(query) > 0 {
+		return sb.String() + "?" + query.Encode(), nil
+	}
+	return sb.String(), nil
+}
+
+func findMethod(method string) (protoreflect.MethodDescriptor, error) {
+	// "/pkg.Service/Method"
+	parts := strings.Split(strings.TrimPrefix(method, "/"), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid grpc method %s", method)
+	}
+
+	desc, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(parts[0]))
+	if err != nil {
+		return nil, fmt.Errorf("find service %s err: %w", parts[0], err)
+	}
+
+	service, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", parts[0])
+	}
+
+	methodDesc := service.Methods().ByName(protoreflect.Name(parts[1]))
+	if methodDesc == nil {
+		return nil, fmt.Errorf("service %s has no method %s", parts[0], parts[1])
+	}
+	return methodDesc, nil
+}
+
+func lookupField(fields map[string]interface{}, name string) (string, bool) {
+	var v interface{} = fields
+	for _, part := range strings.Split(name, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		if v, ok = m[part]; !ok {
+			return "", false
+		}
+	}
+
+	switch v := v.(type) {
+	case string:
+		return url.PathEscape(v), v != ""
+	case nil:
+		return "", false
+	default:
+		return fmt.Sprint(v), true
+	}
+}
+
+// ResponseType returns an empty response message of a grpc query method.
+func ResponseType(method string) (proto.Message, error) {
+	methodDesc, err := findMethod(method)
+	if err != nil {
+		return nil, err
+	}
+
+	name := string(methodDesc.Output().FullName())
+	typ := proto.MessageType(name)
+	if typ == nil {
+		return nil, fmt.Errorf("response type %s is not registered", name)
+	}
+
+	msg, ok := reflect.New(typ.Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("response type %s is not a proto message", name)
+	}
+	return msg, nil
+}
+
+// DecodeBankBalance decodes the output of a BankBalanceQuery.
+func DecodeBankBalance(res *astromeshtypes.FISQueryInstructionResponse) (sdk.Coin, error) {
+	if len(res.Output) != 1 {
+		return sdk.Coin{}, fmt.Errorf("expected 1 output, got %d", len(res.Output))
+	}
+	return decodeCoin(res.Output[0])
+}
+
+// DecodeAstromeshBalances decodes the output of an AstromeshBalanceQuery, one coin per output.
+func DecodeAstromeshBalances(res *astromeshtypes.FISQueryInstructionResponse) ([]sdk.Coin, error) {
+	coins := make([]sdk.Coin, 0, len(res.Output))
+	for i, out := range res.Output {
+		coin, err := decodeCoin(out)
+		if err != nil {
+			return nil, fmt.Errorf("output %d: %w", i, err)
+		}
+		coins = append(coins, coin)
+	}
+	return coins, nil
+}
+
+func decodeCoin(bz []byte) (sdk.Coin, error) {
+	var coin sdk.Coin
+	if err := json.Unmarshal(bz, &coin); err != nil {
+		return sdk.Coin{}, fmt.Errorf("unmarshal coin %s err: %w", string(bz), err)
+	}
+	if coin.Amount.IsNil() {
+		coin.Amount = sdkmath.ZeroInt()
+	}
+	return coin, nil
+}
+
+// DecodeCosmosQuery decodes the json output of a CosmosQuery into res, the response
+// type of the method (see ResponseType).
+func DecodeCosmosQuery(cdc codec.JSONCodec, out *astromeshtypes.FISQueryInstructionResponse, res proto.Message) error {
+	if len(out.Output) != 1 {
+		return fmt.Errorf("expected 1 output, got %d", len(out.Output))
+	}
+	if err := cdc.UnmarshalJSON(out.Output[0], res); err != nil {
+		return fmt.Errorf("unmarshal %T err: %w", res, err)
+	}
+	return nil
+}
+
+// DecodeKVStore decodes the value of a KVStoreQuery, ok is false when the key is not set.
+func DecodeKVStore[V any](out *astromeshtypes.FISQueryInstructionResponse, valueCodec collcodec.ValueCodec[V]) (value V, ok bool, err error) {
+	if len(out.Output) == 0 || len(out.Output[0]) == 0 {
+		return value, false, nil
+	}
+
+	value, err = valueCodec.Decode(out.Output[0])
+	if err != nil {
+		return value, false, fmt.Errorf("decode store value err: %w", err)
+	}
+	return value, true, nil
+}
+
+// DecodeEvents decodes the outputs of an EventQuery, every output is a proto encoded
+// event of the queried type.
+func DecodeEvents[T proto.Message](out *astromeshtypes.FISQueryInstructionResponse, newEvent func() T) ([]T, error) {
+	events := make([]T, 0, len(out.Output))
+	for i, bz := range out.Output {
+		ev := newEvent()
+		if err := proto.Unmarshal(bz, ev); err != nil {
+			return nil, fmt.Errorf("unmarshal event %d err: %w", i, err)
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+// DecodeEvmQuery unpacks the return values of method.
+func DecodeEvmQuery(out *astromeshtypes.FISQueryInstructionResponse, contractABI abi.ABI, method string) ([]interface{}, error) {
+	if len(out.Output) != 1 {
+		return nil, fmt.Errorf("expected 1 output, got %d", len(out.Output))
+	}
+
+	values, err := contractABI.Unpack(method, out.Output[0])
+	if err != nil {
+		return nil, fmt.Errorf("unpack %s output err: %w", method, err)
+	}
+	return values, nil
+}
+
+// DecodeWasmQuery unmarshals the json output of a WasmQuery into v.
+func DecodeWasmQuery(out *astromeshtypes.FISQueryInstructionResponse, v interface{}) error {
+	if len(out.Output) != 1 {
+		return fmt.Errorf("expected 1 output, got %d", len(out.Output))
+	}
+	if err := json.Unmarshal(out.Output[0], v); err != nil {
+		return fmt.Errorf("unmarshal wasm query output err: %w", err)
+	}
+	return nil
+}
+
+// DecodeSvmAccounts decodes the outputs of an SvmAccountsQuery, accounts that do
+// not exist are nil.
+func DecodeSvmAccounts(out *astromeshtypes.FISQueryInstructionResponse) ([]*svmtypes.Account, error) {
+	accounts := make([]*svmtypes.Account, 0, len(out.Output))
+	for i, bz := range out.Output {
+		if len(bz) == 0 {
+			accounts = append(accounts, nil)
+			continue
+		}
+
+		acc := &svmtypes.Account{}
+		if err := acc.Unmarshal(bz); err != nil {
+			return nil, fmt.Errorf("unmarshal svm account %d err: %w", i, err)
+		}
+		accounts = append(accounts, acc)
+	}
+	return accounts, nil
+}
@@ -0,0 +1,240 @@
+package fis
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	evmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/evm/types"
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"google.golang.org/grpc/status"
+)
+
+// InstructionResult is the decoded output of one instruction. Only the field matching
+// the instruction plane and action is set, Output keeps the raw bytes.
+type InstructionResult struct {
+	Index       int
+	Instruction *astromeshtypes.FISInstruction
+	Output      []byte
+
+	// Evm is the result of EVM VM_INVOKE, Output holds the contract return data
+	Evm *evmtypes.MsgExecuteContractResponse
+	// Wasm is the result of WASM VM_INVOKE
+	Wasm *wasmtypes.MsgExecuteContractResponse
+	// Svm is the result of SVM VM_INVOKE, with the compute units consumed
+	Svm *svmtypes.MsgTransactionResponse
+}
+
+// UnpackEvm decodes the EVM return data of method.
+func (r *InstructionResult) UnpackEvm(contractABI abi.ABI, method string) ([]interface{}, error) {
+	if r.Evm == nil {
+		return nil, fmt.Errorf("instruction %d is not an evm invoke", r.Index)
+	}
+
+	values, err := contractABI.Unpack(method, r.Evm.Output)
+	if err != nil {
+		return nil, fmt.Errorf("unpack %s output of instruction %d err: %w", method, r.Index, err)
+	}
+	return values, nil
+}
+
+// SimulationResult is the dry run of a MsgFISTransaction.
+type SimulationResult struct {
+	GasUsed      uint64
+	Instructions []*InstructionResult
+	// BalanceUpdates are the balance changes emitted by all planes
+	BalanceUpdates []*astromeshtypes.BalanceUpdateEvent
+	Events         []abci.Event
+}
+
+// InstructionError is a simulation failure located at the instruction Index.
+type InstructionError struct {
+	Index       int
+	Instruction *astromeshtypes.FISInstruction
+	// Reason is the chain error message
+	Reason string
+	Err    error
+}
+
+func (e *InstructionError) Error() string {
+	if e.Instruction == nil {
+		return fmt.Sprintf("fis transaction failed: %s", e.Reason)
+	}
+	return fmt.Sprintf("instruction %d (%s %s) failed: %s", e.Index, e.Instruction.Plane, e.Instruction.Action, e.Reason)
+}
+
+func (e *InstructionError) Unwrap() error {
+	return e.Err
+}
+
+// Simulate dry runs msg and decodes the result of every instruction. When the
+// simulation fails the error is an *InstructionError naming the first failing
+// instruction, found by simulating growing prefixes of the instructions, or with
+// Index -1 when the failure is not tied to one instruction.
+func Simulate(simulator Simulator, clientCtx client.Context, msg *astromeshtypes.MsgFISTransaction) (*SimulationResult, error) {
+	simRes, err := simulator.SimulateMsg(clientCtx, msg)
+	if err != nil {
+		return nil, locateFailure(simulator, clientCtx, msg, err)
+	}
+
+	res, err := DecodeSimulation(msg, simRes)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Simulate builds the transaction and dry runs it, see Simulate.
+func (b *TxBuilder) Simulate(simulator Simulator) (*SimulationResult, error) {
+	msg, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	return Simulate(simulator, b.clientCtx, msg)
+}
+
+// DecodeSimulation decodes the simulation response of msg.
+func DecodeSimulation(msg *astromeshtypes.MsgFISTransaction, simRes *txtypes.SimulateResponse) (*SimulationResult, error) {
+	res := &SimulationResult{}
+	if simRes.GasInfo != nil {
+		res.GasUsed = simRes.GasInfo.GasUsed
+	}
+	if simRes.Result == nil {
+		return nil, fmt.Errorf("simulation has no result")
+	}
+	res.Events = simRes.Result.Events
+
+	fisRes := &astromeshtypes.MsgFISTransactionResponse{}
+	for _, msgRes := range simRes.Result.MsgResponses {
+		if msgRes.TypeUrl != sdk.MsgTypeURL(fisRes) {
+			continue
+		}
+		if err := proto.Unmarshal(msgRes.Value, fisRes); err != nil {
+			return nil, fmt.Errorf("unmarshal fis transaction response err: %w", err)
+		}
+		break
+	}
+
+	instructions, err := DecodeInstructionResults(msg.Instructions, fisRes.InstructionResponses)
+	if err != nil {
+		return nil, err
+	}
+	res.Instructions = instructions
+
+	balanceUpdateType := proto.MessageName(&astromeshtypes.BalanceUpdateEvent{})
+	for _, ev := range simRes.Result.Events {
+		if ev.Type != balanceUpdateType {
+			continue
+		}
+		typed, err := sdk.ParseTypedEvent(ev)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s err: %w", ev.Type, err)
+		}
+		res.BalanceUpdates = append(res.BalanceUpdates, typed.(*astromeshtypes.BalanceUpdateEvent))
+	}
+
+	return res, nil
+}
+
+// DecodeInstructionResults pairs instructions with their responses and decodes the
+// outputs, instructions without response are left undecoded.
+func DecodeInstructionResults(instructions []*astromeshtypes.FISInstruction, responses []*astromeshtypes.FISInstructionResponse) ([]*InstructionResult, error) {
+	results := make([]*InstructionResult, 0, len(instructions))
+	for i, ix := range instructions {
+		r := &InstructionResult{Index: i, Instruction: ix}
+		if i < len(responses) {
+			r.Output = responses[i].Output
+			if err := decodeInstructionOutput(r); err != nil {
+				return nil, err
+			}
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+// decodeInstructionOutput decodes the output of VM invocations, which are the
+// proto encoded responses of the plane messages.
+func decodeInstructionOutput(r *InstructionResult) error {
+	if r.Instruction.Action != astromeshtypes.TxAction_VM_INVOKE || len(r.Output) == 0 {
+		return nil
+	}
+
+	var out proto.Message
+	switch r.Instruction.Plane {
+	case astromeshtypes.Plane_EVM:
+		r.Evm = &evmtypes.MsgExecuteContractResponse{}
+		out = r.Evm
+	case astromeshtypes.Plane_WASM:
+		r.Wasm = &wasmtypes.MsgExecuteContractResponse{}
+		out = r.Wasm
+	case astromeshtypes.Plane_SVM:
+		r.Svm = &svmtypes.MsgTransactionResponse{}
+		out = r.Svm
+	default:
+		return nil
+	}
+
+	if err := proto.Unmarshal(r.Output, out); err != nil {
+		return fmt.Errorf("unmarshal %s output of instruction %d err: %w", r.Instruction.Plane, r.Index, err)
+	}
+	return nil
+}
+
+// locateFailure finds the shortest prefix of the instructions failing with the reason
+// of simErr, its last instruction is the one failing. The failure is not tied to an
+// instruction, Index -1, when the transaction without instructions fails the same way
+// or no prefix does.
+func locateFailure(simulator Simulator, clientCtx client.Context, msg *astromeshtypes.MsgFISTransaction, simErr error) error {
+	ixErr := &InstructionError{Index: -1, Reason: failureReason(simErr), Err: simErr}
+
+	n := len(msg.Instructions)
+	if n == 0 {
+		return ixErr
+	}
+
+	// e.g. fees, signatures or the sender account fail whatever the instructions
+	baseline := &astromeshtypes.MsgFISTransaction{Sender: msg.Sender}
+	if _, err := simulator.SimulateMsg(clientCtx, baseline); err != nil && failureReason(err) == ixErr.Reason {
+		return ixErr
+	}
+
+	reasons := make(map[int]string, n)
+	failing := sort.Search(n, func(i int) bool {
+		prefix := &astromeshtypes.MsgFISTransaction{
+			Sender:       msg.Sender,
+			Instructions: msg.Instructions[:i+1],
+		}
+		_, err := simulator.SimulateMsg(clientCtx, prefix)
+		if err != nil {
+			reasons[i] = failureReason(err)
+		}
+		return err != nil
+	})
+	if failing == n || reasons[failing] != ixErr.Reason {
+		// the transaction passed this time or failed differently, e.g. the chain
+		// state changed meanwhile
+		return ixErr
+	}
+
+	ixErr.Index = failing
+	ixErr.Instruction = msg.Instructions[failing]
+	return ixErr
+}
+
+// failureReason extracts the chain error message from a simulation error.
+func failureReason(err error) string {
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		return grpcErr.GRPCStatus().Message()
+	}
+	return err.Error()
+}
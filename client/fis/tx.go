@@ -0,0 +1,358 @@
+package fis
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	evmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/evm/types"
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/goccy/go-json"
+)
+
+// AccountLinker resolves the SVM account linked to a cosmos address, implemented by chain.ChainClient.
+type AccountLinker interface {
+	GetSVMAccountLink(ctx context.Context, cosmosAddress sdk.AccAddress) (isLinked bool, pubkey solana.PublicKey, err error)
+}
+
+// Simulator simulates messages, implemented by chain.ChainClient.
+type Simulator interface {
+	SimulateMsg(clientCtx client.Context, msgs ...sdk.Msg) (*txtypes.SimulateResponse, error)
+}
+
+// TxBuilder composes a MsgFISTransaction instruction by instruction, every plane
+// executes atomically in a single cosmos tx. Instruction messages are json encoded
+// with the client codec: COSMOS_INVOKE takes any sdk.Msg with its type url, other
+// actions take their plane's message (MsgSend, MsgAstroTransfer, evm/wasm
+// MsgExecuteContract, svm MsgTransaction).
+//
+// The first error stops the builder, it is returned by Build.
+type TxBuilder struct {
+	clientCtx client.Context
+	cdc       codec.JSONCodec
+	sender    sdk.AccAddress
+	linker    AccountLinker
+
+	instructions []*astromeshtypes.FISInstruction
+	err          error
+}
+
+// NewTxBuilder creates a builder for sender, linker is only needed by SvmInvoke
+// and may be nil otherwise.
+func NewTxBuilder(clientCtx client.Context, sender sdk.AccAddress, linker AccountLinker) *TxBuilder {
+	return &TxBuilder{
+		clientCtx: clientCtx,
+		cdc:       clientCtx.Codec,
+		sender:    sender,
+		linker:    linker,
+	}
+}
+
+func (b *TxBuilder) add(plane astromeshtypes.Plane, action astromeshtypes.TxAction, address []byte, msg []byte) *TxBuilder {
+	b.instructions = append(b.instructions, &astromeshtypes.FISInstruction{
+		Plane:   plane,
+		Action:  action,
+		Address: address,
+		Msg:     msg,
+	})
+	return b
+}
+
+func (b *TxBuilder) fail(err error) *TxBuilder {
+	if b.err == nil {
+		b.err = fmt.Errorf("instruction %d: %w", len(b.instructions), err)
+	}
+	return b
+}
+
+// marshalMsg validates msg and encodes it as instruction payload.
+func (b *TxBuilder) marshalMsg(msg sdk.Msg) ([]byte, error) {
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("validate %T err: %w", msg, err)
+		}
+	}
+
+	bz, err := b.cdc.MarshalJSON(msg)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %T err: %w", msg, err)
+	}
+	return bz, nil
+}
+
+// BankSend sends coins from the sender to a cosmos account.
+func (b *TxBuilder) BankSend(to sdk.AccAddress, amount sdk.Coins) *TxBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	if err := amount.Validate(); err != nil {
+		return b.fail(fmt.Errorf("invalid bank send amount %s: %w", amount, err))
+	}
+	if amount.Empty() {
+		return b.fail(fmt.Errorf("empty bank send amount"))
+	}
+
+	bz, err := b.marshalMsg(banktypes.NewMsgSend(b.sender, to, amount))
+	if err != nil {
+		return b.fail(err)
+	}
+	return b.add(astromeshtypes.Plane_COSMOS, astromeshtypes.TxAction_COSMOS_BANK_SEND, nil, bz)
+}
+
+// AstroTransfer moves coin of the sender from srcPlane to receiver on dstPlane.
+func (b *TxBuilder) AstroTransfer(receiver string, srcPlane, dstPlane astromeshtypes.Plane, coin sdk.Coin) *TxBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	bz, err := b.marshalMsg(&astromeshtypes.MsgAstroTransfer{
+		Sender:   b.sender.String(),
+		Receiver: receiver,
+		SrcPlane: srcPlane,
+		DstPlane: dstPlane,
+		Coin:     coin,
+	})
+	if err != nil {
+		return b.fail(err)
+	}
+	return b.add(astromeshtypes.Plane_COSMOS, astromeshtypes.TxAction_COSMOS_ASTROMESH_TRANSFER, nil, bz)
+}
+
+// CosmosInvoke executes any cosmos msg, its signer must be the sender.
+func (b *TxBuilder) CosmosInvoke(msg sdk.Msg) *TxBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return b.fail(fmt.Errorf("validate %T err: %w", msg, err))
+		}
+	}
+
+	bz, err := b.cdc.MarshalInterfaceJSON(msg)
+	if err != nil {
+		return b.fail(fmt.Errorf("marshal %T err: %w", msg, err))
+	}
+	return b.add(astromeshtypes.Plane_COSMOS, astromeshtypes.TxAction_COSMOS_INVOKE, nil, bz)
+}
+
+// EvmInvoke calls method of an EVM contract with ABI encoded args, value is the
+// amount of lux sent along and may be nil.
+func (b *TxBuilder) EvmInvoke(contract ethcommon.Address, contractABI abi.ABI, value *big.Int, method string, args ...interface{}) *TxBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	calldata, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return b.fail(fmt.Errorf("pack %s calldata err: %w", method, err))
+	}
+	return b.EvmInvokeCalldata(contract, calldata, value)
+}
+
+// EvmInvokeCalldata calls an EVM contract with prepared calldata.
+func (b *TxBuilder) EvmInvokeCalldata(contract ethcommon.Address, calldata []byte, value *big.Int) *TxBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	msg := &evmtypes.MsgExecuteContract{
+		Sender:          b.sender.String(),
+		ContractAddress: contract.Bytes(),
+		Calldata:        calldata,
+	}
+	if value != nil {
+		if value.Sign() < 0 {
+			return b.fail(fmt.Errorf("negative evm value %s", value))
+		}
+		msg.InputAmount = value.Bytes()
+	}
+
+	bz, err := b.marshalMsg(msg)
+	if err != nil {
+		return b.fail(err)
+	}
+	return b.add(astromeshtypes.Plane_EVM, astromeshtypes.TxAction_VM_INVOKE, contract.Bytes(), bz)
+}
+
+// WasmInvoke executes a cosmwasm contract with msg encoded as json, unless it is
+// already []byte or json.RawMessage.
+func (b *TxBuilder) WasmInvoke(contract sdk.AccAddress, msg interface{}, funds sdk.Coins) *TxBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	var executeMsg []byte
+	switch m := msg.(type) {
+	case []byte:
+		executeMsg = m
+	case json.RawMessage:
+		executeMsg = m
+	default:
+		var err error
+		executeMsg, err = json.Marshal(msg)
+		if err != nil {
+			return b.fail(fmt.Errorf("marshal wasm execute msg err: %w", err))
+		}
+	}
+
+	bz, err := b.marshalMsg(&wasmtypes.MsgExecuteContract{
+		Sender:   b.sender.String(),
+		Contract: contract.String(),
+		Msg:      executeMsg,
+		Funds:    funds,
+	})
+	if err != nil {
+		return b.fail(err)
+	}
+	return b.add(astromeshtypes.Plane_WASM, astromeshtypes.TxAction_VM_INVOKE, contract.Bytes(), bz)
+}
+
+// SvmInvoke executes solana instructions paid by the SVM account linked to the sender.
+// The svm signers of the instructions are resolved from the account links, only the
+// sender's linked account may sign as MsgFISTransaction is signed by the sender alone.
+func (b *TxBuilder) SvmInvoke(ctx context.Context, instructions []solana.Instruction) *TxBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	if b.linker == nil {
+		return b.fail(fmt.Errorf("svm invoke requires an account linker"))
+	}
+
+	isLinked, feePayer, err := b.linker.GetSVMAccountLink(ctx, b.sender)
+	if err != nil {
+		return b.fail(fmt.Errorf("get svm account link of %s err: %w", b.sender, err))
+	}
+	if !isLinked {
+		return b.fail(fmt.Errorf("sender %s has no linked svm account", b.sender))
+	}
+
+	tx, err := solana.NewTransaction(instructions, solana.Hash{}, solana.TransactionPayer(feePayer))
+	if err != nil {
+		return b.fail(fmt.Errorf("build svm tx err: %w", err))
+	}
+
+	queryClient := svmtypes.NewQueryClient(b.clientCtx)
+	for _, pubkey := range tx.Message.AccountKeys {
+		if !tx.Message.IsSigner(pubkey) || pubkey.Equals(feePayer) {
+			continue
+		}
+
+		res, err := queryClient.AccountLinkBySvmAddr(ctx, &svmtypes.AccountLinkRequest{Address: pubkey.String()})
+		if err != nil {
+			return b.fail(fmt.Errorf("get account link of svm signer %s err: %w", pubkey, err))
+		}
+		if res.Link == nil {
+			return b.fail(fmt.Errorf("svm signer %s is not linked to a cosmos account", pubkey))
+		}
+		return b.fail(fmt.Errorf("svm signer %s is linked to %s, fis transactions are only signed by the sender %s", pubkey, sdk.AccAddress(res.Link.CosmosAddr), b.sender))
+	}
+
+	signers := []string{b.sender.String()}
+	msg, err := svmtypes.NewMsgTransaction(signers, astromeshtypes.DefaultSvmComputeBudget, tx)
+	if err != nil {
+		return b.fail(err)
+	}
+	bz, err := b.marshalMsg(msg)
+	if err != nil {
+		return b.fail(err)
+	}
+	return b.add(astromeshtypes.Plane_SVM, astromeshtypes.TxAction_VM_INVOKE, nil, bz)
+}
+
+// Instruction appends a prepared instruction as is.
+func (b *TxBuilder) Instruction(ix *astromeshtypes.FISInstruction) *TxBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.instructions = append(b.instructions, ix)
+	return b
+}
+
+// Build returns the validated transaction or the first builder error.
+func (b *TxBuilder) Build() (*astromeshtypes.MsgFISTransaction, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	if len(b.instructions) == 0 {
+		return nil, fmt.Errorf("fis transaction has no instruction")
+	}
+
+	msg := &astromeshtypes.MsgFISTransaction{
+		Sender:       b.sender.String(),
+		Instructions: b.instructions,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("validate fis transaction err: %w", err)
+	}
+
+	return msg, nil
+}
+
+// EstimateGas builds the transaction and simulates it, returning the gas used
+// scaled by gasAdjustment (1 when not positive).
+func (b *TxBuilder) EstimateGas(simulator Simulator, gasAdjustment float64) (msg *astromeshtypes.MsgFISTransaction, gas uint64, err error) {
+	msg, err = b.Build()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	simRes, err := simulator.SimulateMsg(b.clientCtx, msg)
+	if err != nil {
+		return msg, 0, fmt.Errorf("simulate fis transaction err: %w", err)
+	}
+
+	if gasAdjustment <= 0 {
+		gasAdjustment = 1
+	}
+	return msg, uint64(gasAdjustment * float64(simRes.GasInfo.GasUsed)), nil
+}
+
+// DecodeInstruction decodes the message of an instruction encoded like TxBuilder does:
+// any sdk.Msg for COSMOS_INVOKE, the plane message otherwise.
+func DecodeInstruction(cdc codec.JSONCodec, ix *astromeshtypes.FISInstruction) (sdk.Msg, error) {
+	var msg sdk.Msg
+	switch ix.Action {
+	case astromeshtypes.TxAction_COSMOS_INVOKE:
+		if err := cdc.UnmarshalInterfaceJSON(ix.Msg, &msg); err != nil {
+			return nil, fmt.Errorf("unmarshal cosmos invoke msg err: %w", err)
+		}
+		return msg, nil
+	case astromeshtypes.TxAction_COSMOS_BANK_SEND:
+		msg = &banktypes.MsgSend{}
+	case astromeshtypes.TxAction_COSMOS_ASTROMESH_TRANSFER:
+		msg = &astromeshtypes.MsgAstroTransfer{}
+	case astromeshtypes.TxAction_VM_INVOKE:
+		switch ix.Plane {
+		case astromeshtypes.Plane_EVM:
+			msg = &evmtypes.MsgExecuteContract{}
+		case astromeshtypes.Plane_WASM:
+			msg = &wasmtypes.MsgExecuteContract{}
+		case astromeshtypes.Plane_SVM:
+			msg = &svmtypes.MsgTransaction{}
+		default:
+			return nil, fmt.Errorf("unsupported vm invoke on %s", ix.Plane)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported instruction action %s", ix.Action)
+	}
+
+	if err := cdc.UnmarshalJSON(ix.Msg, msg); err != nil {
+		return nil, fmt.Errorf("unmarshal %s %s msg err: %w", ix.Plane, ix.Action, err)
+	}
+	return msg, nil
+}
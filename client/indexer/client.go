@@ -0,0 +1,259 @@
+package indexer
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/account"
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/bazaar"
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/campclash"
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/explorer"
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/fnft"
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/media"
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/ohlcv"
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/provider"
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/web3gw"
+	"github.com/FluxNFTLabs/sdk-go/client/common"
+	log "github.com/InjectiveLabs/suplog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// indexer service names, used as keys of Network.IndexerEndpoints and OptionServiceEndpoint
+const (
+	ServiceExplorer          = "explorer"
+	ServiceFnft              = "fnft"
+	ServiceBazaar            = "bazaar"
+	ServiceCampclash         = "campclash"
+	ServiceCampclashProvider = "campclashProvider"
+	ServiceOhlcv             = "ohlcv"
+	ServiceMedia             = "media"
+	ServiceAccount           = "account"
+	ServiceProvider          = "provider"
+	ServiceWeb3gw            = "web3gw"
+)
+
+var services = []string{
+	ServiceExplorer, ServiceFnft, ServiceBazaar, ServiceCampclash, ServiceCampclashProvider,
+	ServiceOhlcv, ServiceMedia, ServiceAccount, ServiceProvider, ServiceWeb3gw,
+}
+
+func isService(name string) bool {
+	for _, s := range services {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+type IndexerClient interface {
+	Explorer() explorer.APIClient
+	Fnft() fnft.APIClient
+	Bazaar() bazaar.APIClient
+	Campclash() campclash.CampclashQueryClient
+	CampclashProvider() campclash.ProviderQueryClient
+	Ohlcv() ohlcv.APIClient
+	Media() media.APIClient
+	Account() account.APIClient
+	Provider() provider.APIClient
+	Web3gw() web3gw.APIClient
+
+	// Conn returns the connection serving service
+	Conn(service string) *grpc.ClientConn
+	Options() IndexerOptions
+	Close() error
+}
+
+type indexerClient struct {
+	opts   *IndexerOptions
+	logger log.Logger
+
+	// conns is keyed by endpoint, services on the same endpoint share one connection
+	conns    map[string]*grpc.ClientConn
+	services map[string]*grpc.ClientConn
+
+	explorerClient          explorer.APIClient
+	fnftClient              fnft.APIClient
+	bazaarClient            bazaar.APIClient
+	campclashClient         campclash.CampclashQueryClient
+	campclashProviderClient campclash.ProviderQueryClient
+	ohlcvClient             ohlcv.APIClient
+	mediaClient             media.APIClient
+	accountClient           account.APIClient
+	providerClient          provider.APIClient
+	web3gwClient            web3gw.APIClient
+
+	closeOnce sync.Once
+}
+
+// NewIndexerClient connects to the indexer services of network. Every service is
+// served by network.IndexerGrpcEndpoint unless network.IndexerEndpoints or
+// OptionServiceEndpoint says otherwise; services sharing an endpoint share a connection.
+// Services left without an endpoint are not connected and their accessors return nil,
+// only the local network defines indexer endpoints so other networks need
+// OptionServiceEndpoint for the services they use.
+func NewIndexerClient(network common.Network, options ...IndexerOption) (IndexerClient, error) {
+	opts := DefaultIndexerOptions()
+	opts.TLSCert = network.IndexerTlsCert
+	for service, endpoint := range network.IndexerEndpoints {
+		opts.Endpoints[service] = endpoint
+	}
+
+	for _, opt := range options {
+		if err := opt(opts); err != nil {
+			err = errors.Wrap(err, "error in indexer client option")
+			return nil, err
+		}
+	}
+
+	c := &indexerClient{
+		opts: opts,
+		logger: log.WithFields(log.Fields{
+			"module": "sdk-go",
+			"svc":    "indexerClient",
+		}),
+		conns:    map[string]*grpc.ClientConn{},
+		services: map[string]*grpc.ClientConn{},
+	}
+
+	for _, service := range services {
+		endpoint := network.IndexerGrpcEndpoint
+		if e, ok := opts.Endpoints[service]; ok && e != "" {
+			endpoint = e
+		}
+		if endpoint == "" {
+			continue
+		}
+
+		cc, err := c.dial(endpoint)
+		if err != nil {
+			c.Close()
+			return nil, errors.Wrapf(err, "failed to dial indexer %s at %s", service, endpoint)
+		}
+		c.services[service] = cc
+	}
+
+	if len(c.services) == 0 {
+		return nil, fmt.Errorf("no indexer endpoint on network %s", network.Name)
+	}
+
+	if cc, ok := c.services[ServiceExplorer]; ok {
+		c.explorerClient = explorer.NewAPIClient(cc)
+	}
+	if cc, ok := c.services[ServiceFnft]; ok {
+		c.fnftClient = fnft.NewAPIClient(cc)
+	}
+	if cc, ok := c.services[ServiceBazaar]; ok {
+		c.bazaarClient = bazaar.NewAPIClient(cc)
+	}
+	if cc, ok := c.services[ServiceCampclash]; ok {
+		c.campclashClient = campclash.NewCampclashQueryClient(cc)
+	}
+	if cc, ok := c.services[ServiceCampclashProvider]; ok {
+		c.campclashProviderClient = campclash.NewProviderQueryClient(cc)
+	}
+	if cc, ok := c.services[ServiceOhlcv]; ok {
+		c.ohlcvClient = ohlcv.NewAPIClient(cc)
+	}
+	if cc, ok := c.services[ServiceMedia]; ok {
+		c.mediaClient = media.NewAPIClient(cc)
+	}
+	if cc, ok := c.services[ServiceAccount]; ok {
+		c.accountClient = account.NewAPIClient(cc)
+	}
+	if cc, ok := c.services[ServiceProvider]; ok {
+		c.providerClient = provider.NewAPIClient(cc)
+	}
+	if cc, ok := c.services[ServiceWeb3gw]; ok {
+		c.web3gwClient = web3gw.NewAPIClient(cc)
+	}
+
+	return c, nil
+}
+
+func (c *indexerClient) dial(endpoint string) (*grpc.ClientConn, error) {
+	if cc, ok := c.conns[endpoint]; ok {
+		return cc, nil
+	}
+
+	creds := c.opts.TLSCert
+	if creds == nil {
+		creds = insecure.NewCredentials()
+	}
+
+	cc, err := grpc.Dial(
+		endpoint,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithKeepaliveParams(c.opts.Keepalive),
+		grpc.WithUnaryInterceptor(unaryInterceptor(c.opts)),
+		grpc.WithStreamInterceptor(streamInterceptor(c.opts)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.conns[endpoint] = cc
+	return cc, nil
+}
+
+func (c *indexerClient) Explorer() explorer.APIClient {
+	return c.explorerClient
+}
+
+func (c *indexerClient) Fnft() fnft.APIClient {
+	return c.fnftClient
+}
+
+func (c *indexerClient) Bazaar() bazaar.APIClient {
+	return c.bazaarClient
+}
+
+func (c *indexerClient) Campclash() campclash.CampclashQueryClient {
+	return c.campclashClient
+}
+
+func (c *indexerClient) CampclashProvider() campclash.ProviderQueryClient {
+	return c.campclashProviderClient
+}
+
+func (c *indexerClient) Ohlcv() ohlcv.APIClient {
+	return c.ohlcvClient
+}
+
+func (c *indexerClient) Media() media.APIClient {
+	return c.mediaClient
+}
+
+func (c *indexerClient) Account() account.APIClient {
+	return c.accountClient
+}
+
+func (c *indexerClient) Provider() provider.APIClient {
+	return c.providerClient
+}
+
+func (c *indexerClient) Web3gw() web3gw.APIClient {
+	return c.web3gwClient
+}
+
+func (c *indexerClient) Conn(service string) *grpc.ClientConn {
+	return c.services[service]
+}
+
+func (c *indexerClient) Options() IndexerOptions {
+	return *c.opts
+}
+
+func (c *indexerClient) Close() error {
+	var firstErr error
+	c.closeOnce.Do(func() {
+		for endpoint, cc := range c.conns {
+			if err := cc.Close(); err != nil && firstErr == nil {
+				firstErr = errors.Wrapf(err, "failed to close connection to %s", endpoint)
+			}
+		}
+	})
+	return firstErr
+}
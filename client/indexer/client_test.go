@@ -0,0 +1,47 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FluxNFTLabs/sdk-go/client/common"
+)
+
+func TestNewIndexerClientEndpoints(t *testing.T) {
+	network := common.LoadNetwork("local", "")
+	c, err := NewIndexerClient(network, OptionServiceEndpoint(ServiceMedia, "localhost:4499"))
+	require.NoError(t, err)
+	defer c.Close()
+
+	for _, service := range services {
+		require.NotNil(t, c.Conn(service), service)
+	}
+
+	// services on the same endpoint share one connection
+	require.Same(t, c.Conn(ServiceExplorer), c.Conn(ServiceOhlcv))
+	require.Same(t, c.Conn(ServiceCampclash), c.Conn(ServiceCampclashProvider))
+	require.NotSame(t, c.Conn(ServiceExplorer), c.Conn(ServiceFnft))
+
+	require.Equal(t, "localhost:4444", c.Conn(ServiceExplorer).Target())
+	require.Equal(t, "localhost:4447", c.Conn(ServiceFnft).Target())
+	require.Equal(t, "localhost:4499", c.Conn(ServiceMedia).Target())
+}
+
+func TestNewIndexerClientSkipsServicesWithoutEndpoint(t *testing.T) {
+	network := common.LoadNetwork("devnet", "")
+	_, err := NewIndexerClient(network)
+	require.ErrorContains(t, err, "no indexer endpoint")
+
+	c, err := NewIndexerClient(network, OptionServiceEndpoint(ServiceExplorer, "localhost:4444"))
+	require.NoError(t, err)
+	defer c.Close()
+
+	require.NotNil(t, c.Explorer())
+	require.NotNil(t, c.Conn(ServiceExplorer))
+	require.Nil(t, c.Fnft())
+	require.Nil(t, c.Conn(ServiceFnft))
+
+	_, err = NewIndexerClient(network, OptionServiceEndpoint("unknown", "localhost:4444"))
+	require.ErrorContains(t, err, "unknown indexer service")
+}
@@ -0,0 +1,66 @@
+package indexer
+
+import (
+	"context"
+
+	"github.com/FluxNFTLabs/sdk-go/client/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// IsRetryable reports whether err is a transient grpc failure worth retrying.
+func IsRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+func withMetadata(ctx context.Context, md map[string]string) context.Context {
+	for k, v := range md {
+		ctx = metadata.AppendToOutgoingContext(ctx, k, v)
+	}
+	return ctx
+}
+
+// unaryInterceptor attaches metadata, applies the default deadline and retries
+// transient failures with backoff.
+func unaryInterceptor(opts *IndexerOptions) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption,
+	) error {
+		ctx = withMetadata(ctx, opts.Metadata)
+		if _, ok := ctx.Deadline(); !ok && opts.CallTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, opts.CallTimeout)
+			defer cancel()
+		}
+
+		backoff := common.NewBackoff(opts.BackoffMin, opts.BackoffMax)
+		for attempt := 0; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, callOpts...)
+			if err == nil || !IsRetryable(err) || attempt >= opts.MaxRetries {
+				return err
+			}
+
+			if waitErr := backoff.Wait(ctx); waitErr != nil {
+				return err
+			}
+		}
+	}
+}
+
+// streamInterceptor attaches metadata, streams are long lived so they get no deadline
+// and are reconnected by Stream instead of being retried here.
+func streamInterceptor(opts *IndexerOptions) grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn,
+		method string, streamer grpc.Streamer, callOpts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		return streamer(withMetadata(ctx, opts.Metadata), desc, cc, method, callOpts...)
+	}
+}
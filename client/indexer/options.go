@@ -0,0 +1,119 @@
+package indexer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/FluxNFTLabs/sdk-go/client/common"
+	log "github.com/InjectiveLabs/suplog"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	defaultCallTimeout      = 15 * time.Second
+	defaultMaxRetries       = 3
+	defaultKeepaliveTime    = 30 * time.Second
+	defaultKeepaliveTimeout = 10 * time.Second
+)
+
+type IndexerOptions struct {
+	TLSCert credentials.TransportCredentials
+	// Metadata is attached to every outgoing call, e.g. an authorization header
+	Metadata map[string]string
+	// CallTimeout is the deadline of unary calls without one, 0 disables it
+	CallTimeout time.Duration
+	// MaxRetries of unary calls failing with a transient error
+	MaxRetries int
+	BackoffMin time.Duration
+	BackoffMax time.Duration
+	Keepalive  keepalive.ClientParameters
+	// Endpoints overrides the endpoint of a service, keyed by service name
+	Endpoints map[string]string
+}
+
+type IndexerOption func(opts *IndexerOptions) error
+
+func DefaultIndexerOptions() *IndexerOptions {
+	return &IndexerOptions{
+		Metadata:    map[string]string{},
+		CallTimeout: defaultCallTimeout,
+		MaxRetries:  defaultMaxRetries,
+		BackoffMin:  common.DefaultBackoffMin,
+		BackoffMax:  common.DefaultBackoffMax,
+		Keepalive: keepalive.ClientParameters{
+			Time:                defaultKeepaliveTime,
+			Timeout:             defaultKeepaliveTimeout,
+			PermitWithoutStream: true,
+		},
+		Endpoints: map[string]string{},
+	}
+}
+
+func OptionIndexerTLSCert(tlsCert credentials.TransportCredentials) IndexerOption {
+	return func(opts *IndexerOptions) error {
+		if tlsCert == nil {
+			log.Infoln("indexer client does not use grpc secure transport")
+		}
+		opts.TLSCert = tlsCert
+		return nil
+	}
+}
+
+// OptionAuthToken sends "authorization: Bearer <token>" with every call.
+func OptionAuthToken(token string) IndexerOption {
+	return OptionMetadata("authorization", "Bearer "+token)
+}
+
+func OptionMetadata(key, value string) IndexerOption {
+	return func(opts *IndexerOptions) error {
+		if key == "" {
+			return fmt.Errorf("metadata key is empty")
+		}
+		opts.Metadata[key] = value
+		return nil
+	}
+}
+
+func OptionCallTimeout(timeout time.Duration) IndexerOption {
+	return func(opts *IndexerOptions) error {
+		if timeout < 0 {
+			return fmt.Errorf("call timeout must not be negative")
+		}
+		opts.CallTimeout = timeout
+		return nil
+	}
+}
+
+func OptionRetry(maxRetries int, backoffMin, backoffMax time.Duration) IndexerOption {
+	return func(opts *IndexerOptions) error {
+		if maxRetries < 0 {
+			return fmt.Errorf("max retries must not be negative")
+		}
+		if backoffMin <= 0 || backoffMax < backoffMin {
+			return fmt.Errorf("invalid backoff range [%s, %s]", backoffMin, backoffMax)
+		}
+		opts.MaxRetries = maxRetries
+		opts.BackoffMin = backoffMin
+		opts.BackoffMax = backoffMax
+		return nil
+	}
+}
+
+func OptionKeepalive(params keepalive.ClientParameters) IndexerOption {
+	return func(opts *IndexerOptions) error {
+		opts.Keepalive = params
+		return nil
+	}
+}
+
+// OptionServiceEndpoint serves service from endpoint instead of the network indexer endpoint.
+func OptionServiceEndpoint(service, endpoint string) IndexerOption {
+	return func(opts *IndexerOptions) error {
+		if !isService(service) {
+			return fmt.Errorf("unknown indexer service %s", service)
+		}
+		opts.Endpoints[service] = endpoint
+		return nil
+	}
+}
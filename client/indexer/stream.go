@@ -0,0 +1,224 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/FluxNFTLabs/sdk-go/client/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Recver is the receiving side of a generated server-streaming client,
+// e.g. fnft.API_StreamNFTsClient.
+type Recver[T any] interface {
+	Recv() (T, error)
+}
+
+type StreamOptions struct {
+	BackoffMin time.Duration
+	BackoffMax time.Duration
+	// MaxReconnects in a row without receiving a message, 0 reconnects forever
+	MaxReconnects int
+	// SkipReplayed drops messages older than the last received height after a reconnect,
+	// for messages having a GetHeight() uint64 method. Messages at the last height are
+	// delivered again as the stream cannot tell them apart
+	SkipReplayed bool
+	// OnReconnect is called with the error that closed the stream before reconnecting
+	OnReconnect func(err error)
+}
+
+type StreamOption func(opts *StreamOptions) error
+
+func DefaultStreamOptions() *StreamOptions {
+	return &StreamOptions{
+		BackoffMin:   common.DefaultBackoffMin,
+		BackoffMax:   common.DefaultBackoffMax,
+		SkipReplayed: true,
+	}
+}
+
+func OptionStreamBackoff(min, max time.Duration) StreamOption {
+	return func(opts *StreamOptions) error {
+		if min <= 0 || max < min {
+			return fmt.Errorf("invalid backoff range [%s, %s]", min, max)
+		}
+		opts.BackoffMin = min
+		opts.BackoffMax = max
+		return nil
+	}
+}
+
+func OptionMaxReconnects(n int) StreamOption {
+	return func(opts *StreamOptions) error {
+		if n < 0 {
+			return fmt.Errorf("max reconnects must not be negative")
+		}
+		opts.MaxReconnects = n
+		return nil
+	}
+}
+
+func OptionSkipReplayed(skip bool) StreamOption {
+	return func(opts *StreamOptions) error {
+		opts.SkipReplayed = skip
+		return nil
+	}
+}
+
+func OptionOnReconnect(fn func(err error)) StreamOption {
+	return func(opts *StreamOptions) error {
+		opts.OnReconnect = fn
+		return nil
+	}
+}
+
+type heightGetter interface {
+	GetHeight() uint64
+}
+
+// Stream wraps a server-streaming RPC and transparently reopens it with backoff
+// when the connection drops or the server ends the stream, e.g.
+//
+//	stream, _ := indexer.NewStream(ctx, func(ctx context.Context) (indexer.Recver[*fnft.NFTsResponse], error) {
+//		return client.Fnft().StreamNFTs(ctx, &fnft.NFTsRequest{ClassId: "series"})
+//	})
+//	for {
+//		res, err := stream.Recv()
+//		...
+//	}
+//
+// Recv only fails when ctx is done, the server rejects the request (non transient
+// status) or MaxReconnects is exceeded.
+type Stream[T any] struct {
+	ctx  context.Context
+	open func(ctx context.Context) (Recver[T], error)
+	opts *StreamOptions
+
+	mux           sync.Mutex
+	current       Recver[T]
+	cancel        context.CancelFunc
+	backoff       *common.Backoff
+	reconnects    int
+	reconnected   bool
+	lastHeight    uint64
+	hasLastHeight bool
+}
+
+func NewStream[T any](ctx context.Context, open func(ctx context.Context) (Recver[T], error), options ...StreamOption) (*Stream[T], error) {
+	opts := DefaultStreamOptions()
+	for _, opt := range options {
+		if err := opt(opts); err != nil {
+			return nil, fmt.Errorf("error in stream option: %w", err)
+		}
+	}
+
+	return &Stream[T]{
+		ctx:     ctx,
+		open:    open,
+		opts:    opts,
+		backoff: common.NewBackoff(opts.BackoffMin, opts.BackoffMax),
+	}, nil
+}
+
+// Recv returns the next message, reopening the stream as needed.
+func (s *Stream[T]) Recv() (T, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	var zero T
+	for {
+		if err := s.ctx.Err(); err != nil {
+			s.closeCurrent()
+			return zero, err
+		}
+
+		if s.current == nil {
+			ctx, cancel := context.WithCancel(s.ctx)
+			current, err := s.open(ctx)
+			if err != nil {
+				cancel()
+				if waitErr := s.retry(err); waitErr != nil {
+					return zero, waitErr
+				}
+				continue
+			}
+			s.current, s.cancel = current, cancel
+		}
+
+		msg, err := s.current.Recv()
+		if err != nil {
+			s.closeCurrent()
+			if waitErr := s.retry(err); waitErr != nil {
+				return zero, waitErr
+			}
+			continue
+		}
+
+		s.reconnects = 0
+		s.backoff.Reset()
+
+		if h, ok := any(msg).(heightGetter); ok {
+			height := h.GetHeight()
+			if s.reconnected && s.opts.SkipReplayed && s.hasLastHeight && height < s.lastHeight {
+				continue
+			}
+			s.lastHeight, s.hasLastHeight = height, true
+		}
+		s.reconnected = false
+
+		return msg, nil
+	}
+}
+
+// retry waits before the next reconnect, it returns an error when err is final.
+func (s *Stream[T]) retry(err error) error {
+	if ctxErr := s.ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+
+	if err != io.EOF && !isStreamRetryable(err) {
+		return err
+	}
+
+	s.reconnects++
+	if s.opts.MaxReconnects > 0 && s.reconnects > s.opts.MaxReconnects {
+		return fmt.Errorf("stream reconnects exceeded %d, last err: %w", s.opts.MaxReconnects, err)
+	}
+
+	if s.opts.OnReconnect != nil {
+		s.opts.OnReconnect(err)
+	}
+	s.reconnected = true
+
+	return s.backoff.Wait(s.ctx)
+}
+
+func isStreamRetryable(err error) bool {
+	if IsRetryable(err) {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.Internal, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+func (s *Stream[T]) closeCurrent() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.current, s.cancel = nil, nil
+}
+
+// Close stops the underlying stream, the next Recv reopens it unless ctx is done.
+func (s *Stream[T]) Close() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.closeCurrent()
+}
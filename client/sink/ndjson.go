@@ -0,0 +1,173 @@
+package sink
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+type RotateOptions struct {
+	// MaxBytes rotates a file once it grows beyond this size, 0 disables it
+	MaxBytes int64
+	// MaxRecords rotates a file once it holds this many records, 0 disables it
+	MaxRecords int64
+	// MaxAge rotates a file once it is older than this duration, 0 disables it
+	MaxAge time.Duration
+}
+
+// NDJSONSink appends records as json lines into Dir/<table>/<table>-<unix nano>.ndjson,
+// rotating files per RotateOptions. Every line holds the key columns and the message
+// under "data", rows the source removed also carry "deleted": true. Files are
+// append-only: a replay writes duplicated keys, which readers deduplicate by
+// (height, tx_hash, idx).
+type NDJSONSink struct {
+	Dir    string
+	Rotate RotateOptions
+
+	mux   sync.Mutex
+	files map[string]*ndjsonFile
+}
+
+var _ Sink = &NDJSONSink{}
+
+type ndjsonFile struct {
+	f         *os.File
+	w         *bufio.Writer
+	size      int64
+	records   int64
+	createdAt time.Time
+}
+
+type ndjsonLine struct {
+	Height  uint64      `json:"height"`
+	TxHash  string      `json:"tx_hash,omitempty"`
+	Index   int         `json:"idx"`
+	Deleted bool        `json:"deleted,omitempty"`
+	Data    interface{} `json:"data"`
+}
+
+func NewNDJSONSink(dir string, rotate RotateOptions) (*NDJSONSink, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create sink dir err: %w", err)
+	}
+
+	return &NDJSONSink{
+		Dir:    dir,
+		Rotate: rotate,
+		files:  map[string]*ndjsonFile{},
+	}, nil
+}
+
+func (s *NDJSONSink) Write(_ context.Context, records []Record) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, r := range records {
+		table := r.Table()
+		file, err := s.file(table)
+		if err != nil {
+			return err
+		}
+
+		bz, err := json.Marshal(ndjsonLine{
+			Height:  r.Height,
+			TxHash:  r.TxHash,
+			Index:   r.Index,
+			Deleted: r.Deleted,
+			Data:    r.Message,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal %s record at height %d err: %w", table, r.Height, err)
+		}
+
+		bz = append(bz, '\n')
+		if _, err := file.w.Write(bz); err != nil {
+			return fmt.Errorf("write %s err: %w", table, err)
+		}
+
+		file.size += int64(len(bz))
+		file.records++
+	}
+
+	return nil
+}
+
+// file returns the current file of table, rotating it when needed.
+func (s *NDJSONSink) file(table string) (*ndjsonFile, error) {
+	file, ok := s.files[table]
+	if ok && !s.shouldRotate(file) {
+		return file, nil
+	}
+
+	if ok {
+		if err := file.close(); err != nil {
+			return nil, fmt.Errorf("close %s err: %w", table, err)
+		}
+		delete(s.files, table)
+	}
+
+	dir := filepath.Join(s.Dir, table)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	name := filepath.Join(dir, fmt.Sprintf("%s-%d.ndjson", table, now.UnixNano()))
+	f, err := os.OpenFile(name, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("open %s err: %w", name, err)
+	}
+
+	file = &ndjsonFile{f: f, w: bufio.NewWriter(f), createdAt: now}
+	s.files[table] = file
+	return file, nil
+}
+
+func (s *NDJSONSink) shouldRotate(file *ndjsonFile) bool {
+	return (s.Rotate.MaxBytes > 0 && file.size >= s.Rotate.MaxBytes) ||
+		(s.Rotate.MaxRecords > 0 && file.records >= s.Rotate.MaxRecords) ||
+		(s.Rotate.MaxAge > 0 && time.Since(file.createdAt) >= s.Rotate.MaxAge)
+}
+
+func (s *NDJSONSink) Flush() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for table, file := range s.files {
+		if err := file.w.Flush(); err != nil {
+			return fmt.Errorf("flush %s err: %w", table, err)
+		}
+		if err := file.f.Sync(); err != nil {
+			return fmt.Errorf("sync %s err: %w", table, err)
+		}
+	}
+	return nil
+}
+
+func (s *NDJSONSink) Close() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	var firstErr error
+	for table, file := range s.files {
+		if err := file.close(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("close %s err: %w", table, err)
+		}
+		delete(s.files, table)
+	}
+	return firstErr
+}
+
+func (f *ndjsonFile) close() error {
+	if err := f.w.Flush(); err != nil {
+		f.f.Close()
+		return err
+	}
+	return f.f.Close()
+}
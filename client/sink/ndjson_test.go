@@ -0,0 +1,62 @@
+package sink
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/explorer"
+)
+
+func TestNDJSONSinkRotation(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewNDJSONSink(dir, RotateOptions{MaxRecords: 2})
+	require.NoError(t, err)
+
+	var records []Record
+	for h := uint64(1); h <= 5; h++ {
+		records = append(records, ExplorerRecords(&explorer.StreamDumpsadCoinsResponse{
+			Height:  h,
+			Deleted: h / 5,
+			Coin:    &explorer.DumpsadCoin{Denom: "meme"},
+		})...)
+	}
+	ctx := context.Background()
+	require.NoError(t, s.Write(ctx, records[:3]))
+	require.NoError(t, s.Write(ctx, records[3:]))
+	require.NoError(t, s.Close())
+
+	table := SchemaOf(&explorer.DumpsadCoin{}).Table
+	files, err := filepath.Glob(filepath.Join(dir, table, table+"-*.ndjson"))
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+
+	var lines []ndjsonLine
+	for _, name := range files {
+		f, err := os.Open(name)
+		require.NoError(t, err)
+
+		var n int
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var line ndjsonLine
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+			lines = append(lines, line)
+			n++
+		}
+		require.NoError(t, scanner.Err())
+		f.Close()
+		require.LessOrEqual(t, n, 2)
+	}
+
+	require.Len(t, lines, 5)
+	for _, line := range lines {
+		require.Equal(t, "meme", line.TxHash)
+		require.Equal(t, line.Height == 5, line.Deleted)
+	}
+}
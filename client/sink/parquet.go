@@ -0,0 +1,210 @@
+package sink
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// ParquetSink writes records into Dir/<table>/<table>-<unix nano>.parquet with one
+// column per Schema column plus the key columns. Bytes columns are stored as hex
+// strings and nested fields as json strings. Every Flush writes a row group, files
+// are closed and rotated per RotateOptions (MaxBytes is not supported as the final
+// file size is only known once the file is closed).
+type ParquetSink struct {
+	Dir    string
+	Rotate RotateOptions
+
+	mux   sync.Mutex
+	files map[string]*parquetFile
+}
+
+var _ Sink = &ParquetSink{}
+
+type parquetFile struct {
+	f         *os.File
+	w         *writer.JSONWriter
+	records   int64
+	createdAt time.Time
+}
+
+type parquetSchemaItem struct {
+	Tag    string               `json:"Tag"`
+	Fields []*parquetSchemaItem `json:"Fields,omitempty"`
+}
+
+func NewParquetSink(dir string, rotate RotateOptions) (*ParquetSink, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create sink dir err: %w", err)
+	}
+
+	return &ParquetSink{
+		Dir:    dir,
+		Rotate: rotate,
+		files:  map[string]*parquetFile{},
+	}, nil
+}
+
+// ParquetSchema builds the parquet json schema of a table.
+func ParquetSchema(s *Schema) string {
+	root := &parquetSchemaItem{
+		Tag: "name=" + s.Table + ", repetitiontype=REQUIRED",
+		Fields: []*parquetSchemaItem{
+			{Tag: parquetTag(KeyHeight, ColumnUint)},
+			{Tag: parquetTag(KeyTxHash, ColumnString)},
+			{Tag: parquetTag(KeyIndex, ColumnInt)},
+			{Tag: parquetTag(ColDeleted, ColumnBool)},
+		},
+	}
+
+	for _, col := range s.Columns {
+		root.Fields = append(root.Fields, &parquetSchemaItem{Tag: parquetTag(col.Name, col.Kind)})
+	}
+
+	bz, _ := json.Marshal(root)
+	return string(bz)
+}
+
+func parquetTag(name string, kind ColumnKind) string {
+	var typ string
+	switch kind {
+	case ColumnInt:
+		typ = "type=INT64"
+	case ColumnUint:
+		typ = "type=INT64, convertedtype=UINT_64"
+	case ColumnBool:
+		typ = "type=BOOLEAN"
+	case ColumnFloat:
+		typ = "type=DOUBLE"
+	default:
+		typ = "type=BYTE_ARRAY, convertedtype=UTF8"
+	}
+	return fmt.Sprintf("name=%s, %s, repetitiontype=REQUIRED", name, typ)
+}
+
+func (s *ParquetSink) Write(_ context.Context, records []Record) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, r := range records {
+		schema := SchemaOf(r.Message)
+		file, err := s.file(schema)
+		if err != nil {
+			return err
+		}
+
+		values, err := schema.Values(r.Message)
+		if err != nil {
+			return err
+		}
+
+		row := map[string]interface{}{
+			KeyHeight:  r.Height,
+			KeyTxHash:  r.TxHash,
+			KeyIndex:   r.Index,
+			ColDeleted: r.Deleted,
+		}
+		for i, col := range schema.Columns {
+			if bz, ok := values[i].([]byte); ok {
+				row[col.Name] = hex.EncodeToString(bz)
+			} else {
+				row[col.Name] = values[i]
+			}
+		}
+
+		bz, err := json.Marshal(row)
+		if err != nil {
+			return fmt.Errorf("marshal %s row err: %w", schema.Table, err)
+		}
+
+		if err := file.w.Write(string(bz)); err != nil {
+			return fmt.Errorf("write %s row at height %d err: %w", schema.Table, r.Height, err)
+		}
+		file.records++
+	}
+
+	return nil
+}
+
+func (s *ParquetSink) file(schema *Schema) (*parquetFile, error) {
+	table := schema.Table
+	file, ok := s.files[table]
+	if ok && !s.shouldRotate(file) {
+		return file, nil
+	}
+
+	if ok {
+		if err := file.close(); err != nil {
+			return nil, fmt.Errorf("close %s err: %w", table, err)
+		}
+		delete(s.files, table)
+	}
+
+	dir := filepath.Join(s.Dir, table)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	name := filepath.Join(dir, fmt.Sprintf("%s-%d.parquet", table, now.UnixNano()))
+	f, err := os.Create(name)
+	if err != nil {
+		return nil, fmt.Errorf("create %s err: %w", name, err)
+	}
+
+	w, err := writer.NewJSONWriterFromWriter(ParquetSchema(schema), f, 1)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("create parquet writer for %s err: %w", table, err)
+	}
+
+	file = &parquetFile{f: f, w: w, createdAt: now}
+	s.files[table] = file
+	return file, nil
+}
+
+func (s *ParquetSink) shouldRotate(file *parquetFile) bool {
+	return (s.Rotate.MaxRecords > 0 && file.records >= s.Rotate.MaxRecords) ||
+		(s.Rotate.MaxAge > 0 && time.Since(file.createdAt) >= s.Rotate.MaxAge)
+}
+
+func (s *ParquetSink) Flush() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for table, file := range s.files {
+		if err := file.w.Flush(true); err != nil {
+			return fmt.Errorf("flush %s err: %w", table, err)
+		}
+	}
+	return nil
+}
+
+func (s *ParquetSink) Close() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	var firstErr error
+	for table, file := range s.files {
+		if err := file.close(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("close %s err: %w", table, err)
+		}
+		delete(s.files, table)
+	}
+	return firstErr
+}
+
+func (f *parquetFile) close() error {
+	if err := f.w.WriteStop(); err != nil {
+		f.f.Close()
+		return err
+	}
+	return f.f.Close()
+}
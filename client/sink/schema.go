@@ -0,0 +1,204 @@
+package sink
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/goccy/go-json"
+)
+
+type ColumnKind int
+
+const (
+	ColumnInt ColumnKind = iota
+	ColumnUint
+	ColumnBool
+	ColumnFloat
+	ColumnString
+	ColumnBytes
+	// ColumnJSON holds nested messages, repeated and map fields encoded as json
+	ColumnJSON
+)
+
+// Key columns are prepended to every table, they identify a row for idempotent upserts.
+const (
+	KeyHeight = "height"
+	KeyTxHash = "tx_hash"
+	KeyIndex  = "idx"
+)
+
+// ColDeleted follows the key columns, it flags rows the source reported as removed
+// so consumers can drop them instead of treating them as live.
+const ColDeleted = "deleted"
+
+type Column struct {
+	Name string
+	Kind ColumnKind
+
+	fieldIdx   int
+	customType bool
+}
+
+// Schema is a flat table layout derived from a protobuf message type:
+// one column per top level proto field, named after the proto field name.
+type Schema struct {
+	Table   string
+	Columns []Column
+
+	typ reflect.Type
+}
+
+var (
+	schemaMux   sync.Mutex
+	schemaCache = map[reflect.Type]*Schema{}
+)
+
+// SchemaOf derives the schema of msg type, schemas are cached per type.
+func SchemaOf(msg proto.Message) *Schema {
+	typ := reflect.TypeOf(msg).Elem()
+
+	schemaMux.Lock()
+	defer schemaMux.Unlock()
+	if s, ok := schemaCache[typ]; ok {
+		return s
+	}
+
+	s := &Schema{
+		Table: TableName(proto.MessageName(msg)),
+		typ:   typ,
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if !f.IsExported() || strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+
+		tag := f.Tag.Get("protobuf")
+		if tag == "" {
+			// oneof wrappers have only protobuf_oneof tag
+			if oneof := f.Tag.Get("protobuf_oneof"); oneof != "" {
+				s.Columns = append(s.Columns, Column{Name: oneof, Kind: ColumnJSON, fieldIdx: i})
+			}
+			continue
+		}
+
+		col := Column{
+			Name:       protoFieldName(tag, f.Name),
+			fieldIdx:   i,
+			customType: strings.Contains(tag, "customtype="),
+		}
+		switch {
+		case col.customType:
+			col.Kind = ColumnString
+		default:
+			col.Kind = columnKind(f.Type)
+		}
+
+		// key columns are reserved, message fields with the same name get a prefix
+		switch col.Name {
+		case KeyHeight, KeyTxHash, KeyIndex, ColDeleted:
+			col.Name = "msg_" + col.Name
+		}
+
+		s.Columns = append(s.Columns, col)
+	}
+
+	schemaCache[typ] = s
+	return s
+}
+
+// Values returns the column values of msg in schema column order.
+func (s *Schema) Values(msg proto.Message) ([]interface{}, error) {
+	v := reflect.ValueOf(msg)
+	if v.Type().Elem() != s.typ {
+		return nil, fmt.Errorf("message %s does not match table %s", proto.MessageName(msg), s.Table)
+	}
+	v = v.Elem()
+
+	values := make([]interface{}, len(s.Columns))
+	for i, col := range s.Columns {
+		fv := v.Field(col.fieldIdx)
+		if col.customType {
+			values[i] = stringOf(fv)
+			continue
+		}
+
+		switch col.Kind {
+		case ColumnInt:
+			values[i] = fv.Int()
+		case ColumnUint:
+			values[i] = fv.Uint()
+		case ColumnBool:
+			values[i] = fv.Bool()
+		case ColumnFloat:
+			values[i] = fv.Float()
+		case ColumnString:
+			values[i] = fv.String()
+		case ColumnBytes:
+			values[i] = fv.Bytes()
+		default:
+			bz, err := json.Marshal(fv.Interface())
+			if err != nil {
+				return nil, fmt.Errorf("marshal column %s of %s err: %w", col.Name, s.Table, err)
+			}
+			values[i] = string(bz)
+		}
+	}
+
+	return values, nil
+}
+
+// TableName turns a proto message name into a table name,
+// e.g. flux.svm.v1beta1.ExecuteEvent => flux_svm_v1beta1_executeevent
+func TableName(messageName string) string {
+	return strings.ToLower(strings.NewReplacer(".", "_", "/", "_").Replace(messageName))
+}
+
+func columnKind(t reflect.Type) ColumnKind {
+	switch t.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
+		return ColumnInt
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		return ColumnUint
+	case reflect.Bool:
+		return ColumnBool
+	case reflect.Float32, reflect.Float64:
+		return ColumnFloat
+	case reflect.String:
+		return ColumnString
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return ColumnBytes
+		}
+	}
+
+	return ColumnJSON
+}
+
+func protoFieldName(tag, fallback string) string {
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+	return strings.ToLower(fallback)
+}
+
+func stringOf(v reflect.Value) string {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+	} else if v.CanAddr() {
+		v = v.Addr()
+	}
+
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return s.String()
+	}
+	return fmt.Sprint(v.Interface())
+}
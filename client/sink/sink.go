@@ -0,0 +1,104 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// Record is one row written to a sink. Rows are keyed by (Height, TxHash, Index),
+// sinks supporting upserts use this key to make replays idempotent.
+type Record struct {
+	Height uint64
+	TxHash string
+	// Index is the position of the record among records of the same table, height and tx hash
+	Index int
+	// Deleted reports the source removed the row identified by the record
+	Deleted bool
+	Message proto.Message
+}
+
+func (r Record) Table() string {
+	return SchemaOf(r.Message).Table
+}
+
+type Sink interface {
+	Write(ctx context.Context, records []Record) error
+	Flush() error
+	Close() error
+}
+
+// MultiSink writes every batch to all of its sinks in order.
+type MultiSink []Sink
+
+var _ Sink = MultiSink{}
+
+func (m MultiSink) Write(ctx context.Context, records []Record) error {
+	for _, s := range m {
+		if err := s.Write(ctx, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m MultiSink) Flush() error {
+	for _, s := range m {
+		if err := s.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m MultiSink) Close() error {
+	var firstErr error
+	for _, s := range m {
+		if err := s.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Pump receives messages from a server stream, converts them into records and
+// writes them to sink until the stream fails or ctx is done. Use it with the
+// explorer Stream* RPCs, e.g.
+//
+//	stream, _ := explorerClient.StreamTxs(ctx, &explorer.StreamTxsRequest{})
+//	err := sink.Pump(ctx, stream.Recv, sink.ExplorerRecords, s)
+func Pump[M proto.Message](ctx context.Context, recv func() (M, error), convert func(proto.Message) []Record, s Sink) error {
+	for {
+		msg, err := recv()
+		if err != nil {
+			return fmt.Errorf("receive err: %w", err)
+		}
+
+		records := convert(msg)
+		if len(records) == 0 {
+			continue
+		}
+
+		if err := s.Write(ctx, records); err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+// groupByTable splits records per table preserving their order.
+func groupByTable(records []Record) (tables []string, groups map[string][]Record) {
+	groups = map[string][]Record{}
+	for _, r := range records {
+		table := r.Table()
+		if _, ok := groups[table]; !ok {
+			tables = append(tables, table)
+		}
+		groups[table] = append(groups[table], r)
+	}
+	return tables, groups
+}
@@ -0,0 +1,135 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/eventstream/types"
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/explorer"
+	"github.com/FluxNFTLabs/sdk-go/client/eventstream"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// EventsHandler returns an eventstream Handler writing every decoded event to s,
+// one table per event type. The sink is flushed after each block so the consumer
+// checkpoint never gets ahead of written data. Events of unknown types are skipped.
+func EventsHandler(registry *eventstream.EventRegistry, s Sink) eventstream.Handler {
+	if registry == nil {
+		registry = eventstream.NewEventRegistry()
+	}
+
+	return func(ctx context.Context, res *types.EventsResponse) error {
+		block, err := registry.Decode(res)
+		if err != nil {
+			return err
+		}
+
+		if err := s.Write(ctx, BlockRecords(block)); err != nil {
+			return err
+		}
+
+		return s.Flush()
+	}
+}
+
+// BlockRecords converts the decoded events of a block into records.
+func BlockRecords(block *eventstream.DecodedBlock) []Record {
+	records := make([]Record, 0, len(block.Events))
+	indexes := map[string]int{}
+	for _, ev := range block.Events {
+		if ev.Event == nil {
+			continue
+		}
+
+		table := SchemaOf(ev.Event).Table
+		records = append(records, Record{
+			Height:  block.Height,
+			Index:   indexes[table],
+			Message: ev.Event,
+		})
+		indexes[table]++
+	}
+
+	return records
+}
+
+// ExplorerRecords converts explorer Stream* responses into records. Rows that are
+// not transactions are keyed by their natural identifier in TxHash
+// (order, contract address, denom...). Rows the indexer reports as removed are
+// flagged with Deleted. Unsupported messages yield no records.
+func ExplorerRecords(msg proto.Message) []Record {
+	switch res := msg.(type) {
+	case *explorer.StreamTxsResponse:
+		if res.Tx == nil {
+			return nil
+		}
+		return []Record{{Height: res.Height, Deleted: res.Deleted != 0, TxHash: res.Tx.Hash, Message: res.Tx}}
+	case *explorer.StreamBlocksResponse:
+		if res.Block == nil {
+			return nil
+		}
+		return []Record{{Height: res.Height, Deleted: res.Deleted != 0, Message: res.Block}}
+	case *explorer.StreamStrategyTriggerResponse:
+		records := make([]Record, 0, len(res.Triggers))
+		for i, trigger := range res.Triggers {
+			records = append(records, Record{Height: res.Height, Deleted: res.Deleted != 0, Index: i, Message: trigger})
+		}
+		return records
+	case *explorer.StreamStrategiesResponse:
+		records := make([]Record, 0, len(res.Strategies))
+		for i, strategy := range res.Strategies {
+			records = append(records, Record{Height: res.Height, Deleted: res.Deleted != 0, TxHash: fmt.Sprintf("%x", strategy.Id), Index: i, Message: strategy})
+		}
+		return records
+	case *explorer.StreamDriftOrdersResponse:
+		if res.Order == nil {
+			return nil
+		}
+		key := fmt.Sprintf("%s/%d", res.Order.SubaccountAddress, res.Order.OrderId)
+		return []Record{{Height: res.Height, Deleted: res.Deleted != 0, TxHash: key, Message: res.Order}}
+	case *explorer.StreamBalanceResponse:
+		records := make([]Record, 0, len(res.Balances))
+		for _, balance := range res.Balances {
+			key := strings.Join([]string{balance.Acc, balance.Plane.String(), balance.Denom}, "/")
+			records = append(records, Record{Height: res.Height, Deleted: res.Deleted != 0, TxHash: key, Message: balance})
+		}
+		return records
+	case *explorer.StreamTokenMetadataResponse:
+		records := make([]Record, 0, len(res.Metadata))
+		for _, metadata := range res.Metadata {
+			key := metadata.Plane.String() + "/" + metadata.Denom
+			records = append(records, Record{Height: res.Height, Deleted: res.Deleted != 0, TxHash: key, Message: metadata})
+		}
+		return records
+	case *explorer.StreamSvmAccountLinkResponse:
+		records := make([]Record, 0, len(res.AccountLink))
+		for i, link := range res.AccountLink {
+			records = append(records, Record{Height: res.Height, Deleted: res.Deleted != 0, Index: i, Message: link})
+		}
+		return records
+	case *explorer.StreamContractResponse:
+		if res.Contract == nil {
+			return nil
+		}
+		return []Record{{Height: res.Height, Deleted: res.Deleted != 0, TxHash: res.Contract.Address, Message: res.Contract}}
+	case *explorer.StreamDumpsadCoinsResponse:
+		if res.Coin == nil {
+			return nil
+		}
+		return []Record{{Height: res.Height, Deleted: res.Deleted != 0, TxHash: res.Coin.Denom, Message: res.Coin}}
+	case *explorer.StreamDumpsadTradesResponse:
+		if res.Trade == nil {
+			return nil
+		}
+		// trades have no id, key them by content so distinct trades of a trader on the
+		// same denom and block get their own rows. Identical trades still share one.
+		trade := res.Trade
+		key := strings.Join([]string{
+			trade.Denom, trade.Trader, trade.Action, trade.MemeAmount.String(), trade.SolAmount.String(),
+		}, "/")
+		return []Record{{Height: res.Height, Deleted: res.Deleted != 0, TxHash: key, Message: trade}}
+	}
+
+	return nil
+}
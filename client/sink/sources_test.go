@@ -0,0 +1,62 @@
+package sink
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/explorer"
+)
+
+type recordKey struct {
+	height uint64
+	txHash string
+	index  int
+}
+
+func TestExplorerRecordsDumpsadTradesInOneBlock(t *testing.T) {
+	trade := func(action string, meme, sol int64) *explorer.StreamDumpsadTradesResponse {
+		return &explorer.StreamDumpsadTradesResponse{
+			Height: 10,
+			Trade: &explorer.DumpsadTrade{
+				Denom:      "meme",
+				Trader:     "lux1trader",
+				Action:     action,
+				MemeAmount: sdkmath.NewInt(meme),
+				SolAmount:  sdkmath.NewInt(sol),
+				Height:     10,
+			},
+		}
+	}
+
+	keys := map[recordKey]bool{}
+	for _, res := range []*explorer.StreamDumpsadTradesResponse{
+		trade("buy", 100, 1),
+		trade("buy", 200, 2),
+		trade("sell", 100, 1),
+	} {
+		records := ExplorerRecords(res)
+		require.Len(t, records, 1)
+		r := records[0]
+		keys[recordKey{r.Height, r.TxHash, r.Index}] = true
+	}
+	require.Len(t, keys, 3)
+}
+
+func TestExplorerRecordsDeleted(t *testing.T) {
+	records := ExplorerRecords(&explorer.StreamDumpsadCoinsResponse{
+		Height:  5,
+		Deleted: 1,
+		Coin:    &explorer.DumpsadCoin{Denom: "meme"},
+	})
+	require.Len(t, records, 1)
+	require.True(t, records[0].Deleted)
+	require.Equal(t, "meme", records[0].TxHash)
+
+	records = ExplorerRecords(&explorer.StreamDumpsadCoinsResponse{
+		Height: 5,
+		Coin:   &explorer.DumpsadCoin{Denom: "meme"},
+	})
+	require.False(t, records[0].Deleted)
+}
@@ -0,0 +1,185 @@
+package sink
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+)
+
+// SQLSink upserts records into a SQLite database, one table per message type keyed by
+// (height, tx_hash, idx) so replayed blocks overwrite their previous rows. Removed rows
+// are kept with deleted set, readers filter them out. Tables are created on first use.
+// The generated DDL and INSERT ... ON CONFLICT ... excluded statements are SQLite
+// specific, other databases are not supported.
+//
+//	import _ "modernc.org/sqlite"
+//	db, _ := sql.Open("sqlite", "flux.db")
+//	s := sink.NewSQLSink(db)
+type SQLSink struct {
+	db *sql.DB
+
+	mux     sync.Mutex
+	created map[string]string
+}
+
+var _ Sink = &SQLSink{}
+
+// NewSQLSink creates a sink writing to the SQLite database db.
+func NewSQLSink(db *sql.DB) *SQLSink {
+	return &SQLSink{
+		db:      db,
+		created: map[string]string{},
+	}
+}
+
+// CreateTableSQL returns the SQLite CREATE TABLE statement of a table.
+func CreateTableSQL(s *Schema) string {
+	cols := []string{
+		KeyHeight + " INTEGER NOT NULL",
+		KeyTxHash + " TEXT NOT NULL",
+		KeyIndex + " INTEGER NOT NULL",
+		ColDeleted + " BOOLEAN NOT NULL",
+	}
+	for _, col := range s.Columns {
+		cols = append(cols, fmt.Sprintf("%s %s", quoteIdent(col.Name), sqlType(col.Kind)))
+	}
+	cols = append(cols, fmt.Sprintf("PRIMARY KEY (%s, %s, %s)", KeyHeight, KeyTxHash, KeyIndex))
+
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (\n\t%s\n)", quoteIdent(s.Table), strings.Join(cols, ",\n\t"))
+}
+
+func upsertSQL(schema *Schema) string {
+	names := []string{KeyHeight, KeyTxHash, KeyIndex, ColDeleted}
+	updates := []string{fmt.Sprintf("%s = excluded.%s", ColDeleted, ColDeleted)}
+	for _, col := range schema.Columns {
+		names = append(names, quoteIdent(col.Name))
+		updates = append(updates, fmt.Sprintf("%s = excluded.%s", quoteIdent(col.Name), quoteIdent(col.Name)))
+	}
+
+	binds := make([]string, len(names))
+	for i := range names {
+		binds[i] = "?"
+	}
+
+	return fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s, %s, %s) DO UPDATE SET %s",
+		quoteIdent(schema.Table), strings.Join(names, ", "), strings.Join(binds, ", "),
+		KeyHeight, KeyTxHash, KeyIndex, strings.Join(updates, ", "),
+	)
+}
+
+// Write upserts all records in a single database transaction.
+func (s *SQLSink) Write(ctx context.Context, records []Record) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx err: %w", err)
+	}
+	defer tx.Rollback()
+
+	// tables created in tx are only known to exist once it commits
+	created := map[string]string{}
+	tables, groups := groupByTable(records)
+	for _, table := range tables {
+		schema := SchemaOf(groups[table][0].Message)
+		query, isNew, err := s.ensureTable(ctx, tx, schema)
+		if err != nil {
+			return err
+		}
+		if isNew {
+			created[schema.Table] = query
+		}
+
+		stmt, err := tx.PrepareContext(ctx, query)
+		if err != nil {
+			return fmt.Errorf("prepare upsert %s err: %w", table, err)
+		}
+
+		for _, r := range groups[table] {
+			values, err := schema.Values(r.Message)
+			if err != nil {
+				stmt.Close()
+				return err
+			}
+
+			args := append([]interface{}{sqlValue(r.Height), r.TxHash, int64(r.Index), r.Deleted}, values...)
+			for i := range args {
+				args[i] = sqlValue(args[i])
+			}
+
+			if _, err := stmt.ExecContext(ctx, args...); err != nil {
+				stmt.Close()
+				return fmt.Errorf("upsert %s at height %d err: %w", table, r.Height, err)
+			}
+		}
+		stmt.Close()
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit err: %w", err)
+	}
+
+	for table, query := range created {
+		s.created[table] = query
+	}
+	return nil
+}
+
+// ensureTable creates the table of schema in tx if this sink has not done it yet and
+// returns its upsert statement, isNew reports the table was created in tx.
+func (s *SQLSink) ensureTable(ctx context.Context, tx *sql.Tx, schema *Schema) (query string, isNew bool, err error) {
+	if query, ok := s.created[schema.Table]; ok {
+		return query, false, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, CreateTableSQL(schema)); err != nil {
+		return "", false, fmt.Errorf("create table %s err: %w", schema.Table, err)
+	}
+	return upsertSQL(schema), true, nil
+}
+
+// Flush is a no-op, every Write is committed.
+func (s *SQLSink) Flush() error {
+	return nil
+}
+
+// Close does not close the database, it is owned by the caller.
+func (s *SQLSink) Close() error {
+	return nil
+}
+
+func sqlType(kind ColumnKind) string {
+	switch kind {
+	case ColumnInt, ColumnUint:
+		return "INTEGER"
+	case ColumnBool:
+		return "BOOLEAN"
+	case ColumnFloat:
+		return "REAL"
+	case ColumnBytes:
+		return "BLOB"
+	default:
+		return "TEXT"
+	}
+}
+
+// sqlValue converts values database/sql drivers cannot take as is:
+// uint64 above int64 range is stored as its decimal string.
+func sqlValue(v interface{}) interface{} {
+	if u, ok := v.(uint64); ok {
+		if u > math.MaxInt64 {
+			return fmt.Sprintf("%d", u)
+		}
+		return int64(u)
+	}
+	return v
+}
+
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
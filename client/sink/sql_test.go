@@ -0,0 +1,114 @@
+package sink
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/explorer"
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	"github.com/FluxNFTLabs/sdk-go/client/eventstream"
+)
+
+func openTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	// every connection of an in-memory database is a distinct database
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func countRows(t *testing.T, db *sql.DB, query string, args ...interface{}) int {
+	var n int
+	require.NoError(t, db.QueryRow(query, args...).Scan(&n))
+	return n
+}
+
+func TestSQLSinkBlockRecords(t *testing.T) {
+	block := func(contract string) *eventstream.DecodedBlock {
+		return &eventstream.DecodedBlock{
+			Height: 7,
+			Events: []eventstream.DecodedEvent{
+				{Event: &astromeshtypes.WasmContractEvent{ContractAddress: contract}},
+				{Event: nil},
+				{Event: &svmtypes.LinkEvent{}},
+				{Event: &astromeshtypes.WasmContractEvent{ContractAddress: "lux1second"}},
+			},
+		}
+	}
+
+	records := BlockRecords(block("lux1first"))
+	require.Len(t, records, 3)
+	require.Equal(t, 0, records[0].Index)
+	require.Equal(t, 0, records[1].Index)
+	require.Equal(t, 1, records[2].Index)
+
+	ctx := context.Background()
+	db := openTestDB(t)
+	s := NewSQLSink(db)
+	require.NoError(t, s.Write(ctx, records))
+
+	wasmTable := quoteIdent(SchemaOf(&astromeshtypes.WasmContractEvent{}).Table)
+	linkTable := quoteIdent(SchemaOf(&svmtypes.LinkEvent{}).Table)
+	require.Equal(t, 2, countRows(t, db, "SELECT COUNT(*) FROM "+wasmTable))
+	require.Equal(t, 1, countRows(t, db, "SELECT COUNT(*) FROM "+linkTable))
+
+	// replaying the block overwrites its rows instead of duplicating them
+	require.NoError(t, s.Write(ctx, BlockRecords(block("lux1replayed"))))
+	require.Equal(t, 2, countRows(t, db, "SELECT COUNT(*) FROM "+wasmTable))
+
+	var contract string
+	require.NoError(t, db.QueryRow("SELECT contract_address FROM "+wasmTable+" WHERE height = ? AND idx = ?", 7, 0).Scan(&contract))
+	require.Equal(t, "lux1replayed", contract)
+}
+
+func TestSQLSinkExplorerRecords(t *testing.T) {
+	trade := func(action string, meme int64) *explorer.StreamDumpsadTradesResponse {
+		return &explorer.StreamDumpsadTradesResponse{
+			Height: 10,
+			Trade: &explorer.DumpsadTrade{
+				Denom:      "meme",
+				Trader:     "lux1trader",
+				Action:     action,
+				MemeAmount: sdkmath.NewInt(meme),
+				SolAmount:  sdkmath.NewInt(1),
+			},
+		}
+	}
+
+	var records []Record
+	for _, res := range []proto.Message{
+		trade("buy", 100),
+		trade("buy", 200),
+		&explorer.StreamDumpsadCoinsResponse{Height: 10, Coin: &explorer.DumpsadCoin{Denom: "meme"}},
+		&explorer.StreamDumpsadCoinsResponse{Height: 11, Deleted: 1, Coin: &explorer.DumpsadCoin{Denom: "meme"}},
+	} {
+		records = append(records, ExplorerRecords(res)...)
+	}
+	require.Len(t, records, 4)
+
+	ctx := context.Background()
+	db := openTestDB(t)
+	s := NewSQLSink(db)
+	require.NoError(t, s.Write(ctx, records))
+	// a replay of the same responses is idempotent
+	require.NoError(t, s.Write(ctx, records))
+
+	tradeTable := quoteIdent(SchemaOf(&explorer.DumpsadTrade{}).Table)
+	coinTable := quoteIdent(SchemaOf(&explorer.DumpsadCoin{}).Table)
+	require.Equal(t, 2, countRows(t, db, "SELECT COUNT(*) FROM "+tradeTable+" WHERE height = ?", 10))
+	require.Equal(t, 2, countRows(t, db, "SELECT COUNT(*) FROM "+coinTable))
+
+	var deleted bool
+	require.NoError(t, db.QueryRow("SELECT deleted FROM "+coinTable+" WHERE height = ?", 11).Scan(&deleted))
+	require.True(t, deleted)
+	require.NoError(t, db.QueryRow("SELECT deleted FROM "+coinTable+" WHERE height = ?", 10).Scan(&deleted))
+	require.False(t, deleted)
+}
@@ -0,0 +1,338 @@
+package strategy
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/explorer"
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	evmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/evm/types"
+	strategytypes "github.com/FluxNFTLabs/sdk-go/chain/modules/strategy/types"
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	"github.com/FluxNFTLabs/sdk-go/client/fis"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// TriggerRecord is a decoded strategy trigger: what the strategy decided and what
+// executing it did.
+type TriggerRecord struct {
+	StrategyId string
+	// Executor, Height, Time, Fee and GasConsumed are only known for indexed triggers
+	Executor    string
+	Height      int64
+	Time        time.Time
+	Success     bool
+	Error       string
+	GasConsumed uint64
+	Fee         sdkmath.Int
+	// Instructions are the instructions output by the strategy with their results,
+	// Msgs their decoded messages
+	Instructions []*fis.InstructionResult
+	Msgs         []sdk.Msg
+	Events       []*strategytypes.StrategyEvent
+	Result       string
+
+	// DecodeErr is set when the trigger is not fully decoded, e.g. an instruction of an
+	// unsupported action. Msgs of undecoded instructions are nil, undecoded outputs are
+	// kept raw in RawOutput and RawFisOutput.
+	DecodeErr    error
+	RawOutput    []byte
+	RawFisOutput []byte
+}
+
+func (r *TriggerRecord) decodeFailed(err error) {
+	r.DecodeErr = errors.Join(r.DecodeErr, err)
+}
+
+// Topics returns the topics of the strategy events.
+func (r *TriggerRecord) Topics() []string {
+	topics := make([]string, 0, len(r.Events))
+	for _, ev := range r.Events {
+		topics = append(topics, ev.Topic)
+	}
+	return topics
+}
+
+// TriggerDecoder decodes strategy triggers from the explorer and tx responses.
+type TriggerDecoder struct {
+	cdc codec.Codec
+}
+
+// NewTriggerDecoder creates a decoder, cdc is the chain codec from chaintypes.NewClientContext.
+func NewTriggerDecoder(cdc codec.Codec) *TriggerDecoder {
+	return &TriggerDecoder{cdc: cdc}
+}
+
+// DecodeEvent decodes an indexed trigger, decoding failures are set on DecodeErr.
+func (d *TriggerDecoder) DecodeEvent(ev *strategytypes.StrategyTriggerEvent) *TriggerRecord {
+	r := &TriggerRecord{
+		StrategyId:  hex.EncodeToString(ev.Id),
+		Executor:    ev.Executor,
+		Height:      ev.Height,
+		Time:        time.Unix(ev.Time, 0),
+		Success:     ev.Success,
+		Error:       ev.Error,
+		GasConsumed: ev.GasConsumed,
+		Fee:         ev.Fee,
+	}
+
+	output, err := d.decodeOutput(ev.Instructions)
+	if err != nil {
+		r.RawOutput = ev.Instructions
+		r.RawFisOutput = ev.FisTransactionOutput
+		r.decodeFailed(fmt.Errorf("decode output of trigger %s at height %d err: %w", r.StrategyId, ev.Height, err))
+		return r
+	}
+	r.Events = output.Events
+	r.Result = output.Result
+
+	fisRes := &astromeshtypes.MsgFISTransactionResponse{}
+	if len(ev.FisTransactionOutput) > 0 {
+		if err := proto.Unmarshal(ev.FisTransactionOutput, fisRes); err != nil {
+			r.RawFisOutput = ev.FisTransactionOutput
+			r.decodeFailed(fmt.Errorf("unmarshal fis transaction output err: %w", err))
+			fisRes.Reset()
+		}
+	}
+
+	d.decodeInstructions(r, output.Instructions, fisRes.InstructionResponses)
+	return r
+}
+
+// decodeOutput decodes the strategy output, kept as the json returned by the strategy
+// or proto encoded.
+func (d *TriggerDecoder) decodeOutput(bz []byte) (*strategytypes.StrategyOutput, error) {
+	output := &strategytypes.StrategyOutput{}
+	if len(bz) == 0 {
+		return output, nil
+	}
+
+	if bz[0] == '{' {
+		if err := d.cdc.UnmarshalJSON(bz, output); err == nil {
+			return output, nil
+		}
+		output.Reset()
+	}
+	if err := proto.Unmarshal(bz, output); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// decodeInstructions decodes what it can of the instructions and their responses,
+// failures are set on the record.
+func (d *TriggerDecoder) decodeInstructions(r *TriggerRecord, instructions []*astromeshtypes.FISInstruction, responses []*astromeshtypes.FISInstructionResponse) {
+	results, err := fis.DecodeInstructionResults(instructions, responses)
+	if err != nil {
+		r.decodeFailed(err)
+		// without responses nothing is decoded, the outputs stay raw
+		results, _ = fis.DecodeInstructionResults(instructions, nil)
+		for i := range results {
+			if i < len(responses) {
+				results[i].Output = responses[i].Output
+			}
+		}
+	}
+	r.Instructions = results
+
+	r.Msgs = make([]sdk.Msg, len(instructions))
+	for i, ix := range instructions {
+		msg, err := fis.DecodeInstruction(d.cdc, ix)
+		if err != nil {
+			r.decodeFailed(fmt.Errorf("decode instruction %d err: %w", i, err))
+			continue
+		}
+		r.Msgs[i] = msg
+	}
+}
+
+// DecodeResponse decodes the strategy responses of a MsgTriggerStrategies, which are
+// successful triggers, decoding failures are set on DecodeErr.
+func (d *TriggerDecoder) DecodeResponse(res *strategytypes.MsgTriggerStrategiesResponse) []*TriggerRecord {
+	records := make([]*TriggerRecord, 0, len(res.StrategyTriggerResponses))
+	for _, sr := range res.StrategyTriggerResponses {
+		r := &TriggerRecord{
+			StrategyId: sr.Id,
+			Success:    true,
+			Result:     sr.Result,
+		}
+		d.decodeInstructions(r, sr.Ixs, sr.IxResponses)
+		records = append(records, r)
+	}
+	return records
+}
+
+// DecodeTx decodes the triggers of the MsgTriggerStrategies of a broadcast tx.
+func (d *TriggerDecoder) DecodeTx(res *txtypes.BroadcastTxResponse) ([]*TriggerRecord, error) {
+	data, err := hex.DecodeString(res.TxResponse.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decode tx data err: %w", err)
+	}
+
+	var txData sdk.TxMsgData
+	if err := txData.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("unmarshal tx data err: %w", err)
+	}
+
+	var records []*TriggerRecord
+	typeUrl := sdk.MsgTypeURL(&strategytypes.MsgTriggerStrategies{}) + "Response"
+	for _, msgRes := range txData.MsgResponses {
+		if msgRes.TypeUrl != typeUrl {
+			continue
+		}
+
+		var triggerRes strategytypes.MsgTriggerStrategiesResponse
+		if err := triggerRes.Unmarshal(msgRes.Value); err != nil {
+			return nil, fmt.Errorf("unmarshal trigger strategies response err: %w", err)
+		}
+		msgRecords := d.DecodeResponse(&triggerRes)
+		for _, r := range msgRecords {
+			r.Height = res.TxResponse.Height
+		}
+		records = append(records, msgRecords...)
+	}
+	return records, nil
+}
+
+// Format prints the record for humans, one line per instruction.
+func (d *TriggerDecoder) Format(r *TriggerRecord) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "strategy %s", r.StrategyId)
+	if r.Height > 0 {
+		fmt.Fprintf(&b, " at height %d", r.Height)
+	}
+	if r.Time.Unix() > 0 {
+		fmt.Fprintf(&b, " (%s)", r.Time.UTC().Format(time.RFC3339))
+	}
+	if r.Executor != "" {
+		fmt.Fprintf(&b, " by %s", r.Executor)
+	}
+	if r.Success {
+		b.WriteString(": success")
+	} else {
+		fmt.Fprintf(&b, ": failed: %s", r.Error)
+	}
+	if r.GasConsumed > 0 {
+		fmt.Fprintf(&b, ", gas %d", r.GasConsumed)
+	}
+	if !r.Fee.IsNil() && r.Fee.IsPositive() {
+		fmt.Fprintf(&b, ", fee %s", r.Fee)
+	}
+	b.WriteString("\n")
+
+	for i, ix := range r.Instructions {
+		if r.Msgs[i] == nil {
+			fmt.Fprintf(&b, "  [%d] %s %s undecoded, %d bytes\n", i, strings.ToLower(ix.Instruction.Plane.String()), ix.Instruction.Action, len(ix.Instruction.Msg))
+			continue
+		}
+		fmt.Fprintf(&b, "  [%d] %s\n", i, d.FormatInstruction(ix.Instruction, r.Msgs[i]))
+	}
+	for _, ev := range r.Events {
+		fmt.Fprintf(&b, "  event %s: %s\n", ev.Topic, ev.Data)
+	}
+	if r.Result != "" {
+		fmt.Fprintf(&b, "  result: %s\n", r.Result)
+	}
+	if r.DecodeErr != nil {
+		fmt.Fprintf(&b, "  decode error: %s\n", strings.ReplaceAll(r.DecodeErr.Error(), "\n", "; "))
+	}
+	return b.String()
+}
+
+// FormatInstruction describes an instruction and its decoded message on one line.
+func (d *TriggerDecoder) FormatInstruction(ix *astromeshtypes.FISInstruction, msg sdk.Msg) string {
+	switch m := msg.(type) {
+	case *banktypes.MsgSend:
+		return fmt.Sprintf("cosmos send %s from %s to %s", m.Amount, m.FromAddress, m.ToAddress)
+	case *astromeshtypes.MsgAstroTransfer:
+		return fmt.Sprintf("astromesh transfer %s from %s to %s %s", m.Coin, m.SrcPlane, m.DstPlane, m.Receiver)
+	case *evmtypes.MsgExecuteContract:
+		s := fmt.Sprintf("evm call 0x%x", m.ContractAddress)
+		if len(m.Calldata) >= 4 {
+			s += fmt.Sprintf(" selector 0x%x, %d bytes calldata", m.Calldata[:4], len(m.Calldata))
+		}
+		if value := new(big.Int).SetBytes(m.InputAmount); value.Sign() > 0 {
+			s += fmt.Sprintf(", value %s", value)
+		}
+		return s
+	case *wasmtypes.MsgExecuteContract:
+		s := fmt.Sprintf("wasm execute %s %s", m.Contract, m.Msg)
+		if !m.Funds.Empty() {
+			s += fmt.Sprintf(", funds %s", m.Funds)
+		}
+		return s
+	case *svmtypes.MsgTransaction:
+		programs := make([]string, 0, len(m.Instructions))
+		for _, svmIx := range m.Instructions {
+			for _, idx := range svmIx.ProgramIndex {
+				if int(idx) < len(m.Accounts) {
+					programs = append(programs, m.Accounts[idx])
+				}
+			}
+		}
+		return fmt.Sprintf("svm tx of %d instructions, programs [%s], signers %s", len(m.Instructions), strings.Join(programs, " "), strings.Join(m.Signers, " "))
+	}
+
+	bz, err := d.cdc.MarshalInterfaceJSON(msg)
+	if err != nil {
+		return fmt.Sprintf("%s %s %s", strings.ToLower(ix.Plane.String()), ix.Action, sdk.MsgTypeURL(msg))
+	}
+	return fmt.Sprintf("%s %s %s", strings.ToLower(ix.Plane.String()), ix.Action, bz)
+}
+
+// Auditor builds the audit trail of strategies from the explorer.
+type Auditor struct {
+	explorer TriggerExplorer
+	decoder  *TriggerDecoder
+}
+
+func NewAuditor(explorer TriggerExplorer, decoder *TriggerDecoder) *Auditor {
+	return &Auditor{
+		explorer: explorer,
+		decoder:  decoder,
+	}
+}
+
+// AuditLog returns the decoded triggers of a strategy between from and to, zero times
+// are unbounded. Triggers failing to decode are returned with their DecodeErr set.
+func (a *Auditor) AuditLog(ctx context.Context, id string, from, to time.Time) ([]*TriggerRecord, error) {
+	req := &explorer.ListStrategyTriggerByIdRequest{
+		Id:         id,
+		Pagination: &query.PageRequest{Limit: 100},
+	}
+	if !from.IsZero() {
+		req.FromTime = from.Unix()
+	}
+	if !to.IsZero() {
+		req.ToTime = to.Unix()
+	}
+
+	var records []*TriggerRecord
+	for {
+		res, err := a.explorer.ListStrategyTriggersById(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("list triggers of %s err: %w", id, err)
+		}
+
+		for _, ev := range res.Triggers {
+			records = append(records, a.decoder.DecodeEvent(ev))
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 || len(res.Triggers) == 0 {
+			return records, nil
+		}
+		req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 100}
+	}
+}
@@ -0,0 +1,418 @@
+package strategy
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/explorer"
+	strategytypes "github.com/FluxNFTLabs/sdk-go/chain/modules/strategy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc"
+)
+
+// CronClient is the part of chain.ChainClient used by CronManager.
+type CronClient interface {
+	Broadcaster
+	GetBankBalance(ctx context.Context, address string, denom string) (*banktypes.QueryBalanceResponse, error)
+}
+
+// TriggerExplorer is the part of the explorer API used by CronManager.
+type TriggerExplorer interface {
+	ListStrategyTriggersById(ctx context.Context, in *explorer.ListStrategyTriggerByIdRequest, opts ...grpc.CallOption) (*explorer.ListStrategyTriggerByIdResponse, error)
+	StreamStrategyTriggers(ctx context.Context, in *explorer.StreamStrategyTriggerRequest, opts ...grpc.CallOption) (explorer.API_StreamStrategyTriggersClient, error)
+}
+
+type CronManagerOptions struct {
+	// FeeDenom is the denom trigger fees are paid in
+	FeeDenom string
+	// FeeSamples is the number of recent triggers the average fee is computed from
+	FeeSamples uint64
+	// MissedTolerance is the number of missed intervals tolerated before alerting
+	MissedTolerance uint64
+	// MinRemainingTriggers is the funding level, in triggers, alerted on
+	MinRemainingTriggers uint64
+}
+
+type CronManagerOption func(opts *CronManagerOptions) error
+
+func DefaultCronManagerOptions() *CronManagerOptions {
+	return &CronManagerOptions{
+		FeeDenom:             "lux",
+		FeeSamples:           20,
+		MissedTolerance:      1,
+		MinRemainingTriggers: 100,
+	}
+}
+
+func OptionFeeDenom(denom string) CronManagerOption {
+	return func(opts *CronManagerOptions) error {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		opts.FeeDenom = denom
+		return nil
+	}
+}
+
+func OptionFeeSamples(samples uint64) CronManagerOption {
+	return func(opts *CronManagerOptions) error {
+		if samples == 0 {
+			return fmt.Errorf("fee samples must be positive")
+		}
+		opts.FeeSamples = samples
+		return nil
+	}
+}
+
+func OptionMissedTolerance(intervals uint64) CronManagerOption {
+	return func(opts *CronManagerOptions) error {
+		opts.MissedTolerance = intervals
+		return nil
+	}
+}
+
+func OptionMinRemainingTriggers(triggers uint64) CronManagerOption {
+	return func(opts *CronManagerOptions) error {
+		opts.MinRemainingTriggers = triggers
+		return nil
+	}
+}
+
+// BotStatus is the operational status of a cron bot. Trigger fees are paid by the bot
+// owner, the funding is the owner balance of the fee denom.
+type BotStatus struct {
+	Strategy *strategytypes.Strategy
+	// LastTrigger is nil when the bot was never triggered
+	LastTrigger *strategytypes.StrategyTriggerEvent
+	// AvgFee is the average fee of the recent triggers, zero when never triggered
+	AvgFee  sdkmath.Int
+	Balance sdk.Coin
+	// RemainingTriggers is the number of triggers the balance pays for at AvgFee
+	RemainingTriggers uint64
+	// Missed is the number of intervals elapsed since the last trigger, always 0 for
+	// event-based bots
+	Missed uint64
+}
+
+func (s *BotStatus) Id() string {
+	return hex.EncodeToString(s.Strategy.Id)
+}
+
+// EventBased reports whether the bot is triggered by events instead of an interval.
+func (s *BotStatus) EventBased() bool {
+	return s.Strategy.Metadata.CronInterval == 0
+}
+
+// Runway estimates how long the balance funds an interval bot, 0 if unknown.
+func (s *BotStatus) Runway() time.Duration {
+	return time.Duration(s.RemainingTriggers*s.Strategy.Metadata.CronInterval) * time.Second
+}
+
+type AlertKind string
+
+const (
+	AlertMissed        AlertKind = "missed"
+	AlertFailed        AlertKind = "failed"
+	AlertLowFunds      AlertKind = "low_funds"
+	AlertNeverExecuted AlertKind = "never_executed"
+)
+
+type Alert struct {
+	Kind    AlertKind
+	Status  *BotStatus
+	Message string
+}
+
+// CronUpdate changes the cron settings of a bot, nil fields are kept.
+type CronUpdate struct {
+	Id       string
+	GasPrice *sdkmath.Int
+	Input    *string
+	Interval *uint64
+}
+
+// CronManager operates the cron and event-based bots of an owner.
+type CronManager struct {
+	opts        *CronManagerOptions
+	client      CronClient
+	queryClient strategytypes.QueryClient
+	explorer    TriggerExplorer
+}
+
+func NewCronManager(client CronClient, queryClient strategytypes.QueryClient, explorer TriggerExplorer, options ...CronManagerOption) (*CronManager, error) {
+	opts := DefaultCronManagerOptions()
+	for _, opt := range options {
+		if err := opt(opts); err != nil {
+			return nil, fmt.Errorf("error in cron manager option: %w", err)
+		}
+	}
+
+	return &CronManager{
+		opts:        opts,
+		client:      client,
+		queryClient: queryClient,
+		explorer:    explorer,
+	}, nil
+}
+
+// List returns the live cron bots of the sender.
+func (m *CronManager) List(ctx context.Context) ([]*strategytypes.Strategy, error) {
+	res, err := m.queryClient.ListStrategiesByOwner(ctx, &strategytypes.ListStrategiesByOwnerRequest{
+		Owner: m.client.FromAddress().String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list strategies err: %w", err)
+	}
+
+	var bots []*strategytypes.Strategy
+	for _, s := range res.Strategies {
+		if s.Deleted == 0 && s.Metadata != nil && s.Metadata.Type == strategytypes.StrategyType_CRON {
+			bots = append(bots, s)
+		}
+	}
+	return bots, nil
+}
+
+// Status returns the status of a bot at now. Trigger times and cron intervals are in seconds.
+func (m *CronManager) Status(ctx context.Context, s *strategytypes.Strategy, now time.Time) (*BotStatus, error) {
+	triggers, err := m.explorer.ListStrategyTriggersById(ctx, &explorer.ListStrategyTriggerByIdRequest{
+		Id:         hex.EncodeToString(s.Id),
+		Pagination: &query.PageRequest{Limit: m.opts.FeeSamples, Reverse: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list triggers of %s err: %w", hex.EncodeToString(s.Id), err)
+	}
+
+	balance, err := m.client.GetBankBalance(ctx, s.Owner, m.opts.FeeDenom)
+	if err != nil {
+		return nil, fmt.Errorf("get balance of %s err: %w", s.Owner, err)
+	}
+
+	status := &BotStatus{
+		Strategy: s,
+		AvgFee:   sdkmath.ZeroInt(),
+		Balance:  *balance.Balance,
+	}
+
+	totalFee := sdkmath.ZeroInt()
+	for _, t := range triggers.Triggers {
+		if status.LastTrigger == nil || t.Height > status.LastTrigger.Height {
+			status.LastTrigger = t
+		}
+		if !t.Fee.IsNil() {
+			totalFee = totalFee.Add(t.Fee)
+		}
+	}
+
+	if len(triggers.Triggers) > 0 {
+		status.AvgFee = totalFee.QuoRaw(int64(len(triggers.Triggers)))
+	}
+	if status.AvgFee.IsPositive() {
+		if remaining := status.Balance.Amount.Quo(status.AvgFee); remaining.IsUint64() {
+			status.RemainingTriggers = remaining.Uint64()
+		}
+	}
+
+	if interval := s.Metadata.CronInterval; interval > 0 && status.LastTrigger != nil {
+		elapsed := now.Unix() - status.LastTrigger.Time
+		if elapsed > 0 && uint64(elapsed) > interval {
+			status.Missed = uint64(elapsed)/interval - 1
+		}
+	}
+	return status, nil
+}
+
+// Statuses returns the status of all bots of the sender.
+func (m *CronManager) Statuses(ctx context.Context, now time.Time) ([]*BotStatus, error) {
+	bots, err := m.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*BotStatus, 0, len(bots))
+	for _, s := range bots {
+		status, err := m.Status(ctx, s, now)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Check returns the alerts of the enabled bots of the sender at now.
+func (m *CronManager) Check(ctx context.Context, now time.Time) ([]Alert, error) {
+	statuses, err := m.Statuses(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var alerts []Alert
+	for _, s := range statuses {
+		if !s.Strategy.IsEnabled {
+			continue
+		}
+		alerts = append(alerts, m.alerts(s)...)
+	}
+	return alerts, nil
+}
+
+func (m *CronManager) alerts(s *BotStatus) []Alert {
+	var alerts []Alert
+	switch {
+	case s.LastTrigger == nil && !s.EventBased():
+		alerts = append(alerts, Alert{
+			Kind:    AlertNeverExecuted,
+			Status:  s,
+			Message: fmt.Sprintf("bot %s was never triggered", s.Id()),
+		})
+	case s.LastTrigger != nil && !s.LastTrigger.Success:
+		alerts = append(alerts, Alert{
+			Kind:    AlertFailed,
+			Status:  s,
+			Message: fmt.Sprintf("bot %s last trigger at height %d failed: %s", s.Id(), s.LastTrigger.Height, s.LastTrigger.Error),
+		})
+	}
+
+	if s.Missed > m.opts.MissedTolerance {
+		alerts = append(alerts, Alert{
+			Kind:    AlertMissed,
+			Status:  s,
+			Message: fmt.Sprintf("bot %s missed %d intervals of %ds", s.Id(), s.Missed, s.Strategy.Metadata.CronInterval),
+		})
+	}
+
+	if s.AvgFee.IsPositive() && s.RemainingTriggers < m.opts.MinRemainingTriggers {
+		alerts = append(alerts, Alert{
+			Kind:    AlertLowFunds,
+			Status:  s,
+			Message: fmt.Sprintf("bot %s owner balance %s pays for %d more triggers", s.Id(), s.Balance, s.RemainingTriggers),
+		})
+	}
+	return alerts
+}
+
+// Watch streams the triggers of the bots until ctx is done, calling handler for each.
+func (m *CronManager) Watch(ctx context.Context, ids []string, handler func(*strategytypes.StrategyTriggerEvent)) error {
+	stream, err := m.explorer.StreamStrategyTriggers(ctx, &explorer.StreamStrategyTriggerRequest{Id: ids})
+	if err != nil {
+		return fmt.Errorf("stream strategy triggers err: %w", err)
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("receive strategy triggers err: %w", err)
+		}
+
+		sort.Slice(res.Triggers, func(i, j int) bool {
+			return res.Triggers[i].Height < res.Triggers[j].Height
+		})
+		for _, t := range res.Triggers {
+			handler(t)
+		}
+	}
+}
+
+// Pause disables the bots in a single tx.
+func (m *CronManager) Pause(ids ...string) (*txtypes.BroadcastTxResponse, error) {
+	return m.toggle(strategytypes.Config_disable, ids)
+}
+
+// Resume enables the bots in a single tx.
+func (m *CronManager) Resume(ids ...string) (*txtypes.BroadcastTxResponse, error) {
+	return m.toggle(strategytypes.Config_enable, ids)
+}
+
+func (m *CronManager) toggle(config strategytypes.Config, ids []string) (*txtypes.BroadcastTxResponse, error) {
+	msgs := make([]sdk.Msg, 0, len(ids))
+	for _, id := range ids {
+		msg := &strategytypes.MsgConfigStrategy{
+			Sender: m.client.FromAddress().String(),
+			Config: config,
+			Id:     id,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("%s %s: %w", config, id, err)
+		}
+		msgs = append(msgs, msg)
+	}
+	return broadcast(m.client, msgs)
+}
+
+// Update applies the cron updates in a single tx, every updated config is validated like
+// the chain does, including the CRON_MINIMUM_GAS_PRICE.
+func (m *CronManager) Update(ctx context.Context, updates ...CronUpdate) (*txtypes.BroadcastTxResponse, error) {
+	msgs := make([]sdk.Msg, 0, len(updates))
+	for _, u := range updates {
+		msg, err := m.UpdateMsg(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return broadcast(m.client, msgs)
+}
+
+// UpdateMsg builds the message applying u to the deployed bot.
+func (m *CronManager) UpdateMsg(ctx context.Context, u CronUpdate) (*strategytypes.MsgConfigStrategy, error) {
+	res, err := m.queryClient.GetStrategyById(ctx, &strategytypes.GetStrategyByIdRequest{Id: u.Id})
+	if err != nil {
+		return nil, fmt.Errorf("get strategy %s err: %w", u.Id, err)
+	}
+
+	s := res.Strategy
+	if s.Metadata == nil || s.Metadata.Type != strategytypes.StrategyType_CRON {
+		return nil, fmt.Errorf("strategy %s is not a cron bot", u.Id)
+	}
+
+	metadata := *s.Metadata
+	if u.GasPrice != nil {
+		metadata.CronGasPrice = *u.GasPrice
+	}
+	if u.Input != nil {
+		metadata.CronInput = *u.Input
+	}
+	if u.Interval != nil {
+		metadata.CronInterval = *u.Interval
+	}
+
+	msg := &strategytypes.MsgConfigStrategy{
+		Sender:            m.client.FromAddress().String(),
+		Config:            strategytypes.Config_update,
+		Id:                u.Id,
+		Query:             s.Query,
+		TriggerPermission: s.TriggerPermission,
+		Metadata:          &metadata,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("update %s: %w", u.Id, err)
+	}
+	return msg, nil
+}
+
+// broadcast sends msgs in a single tx, failing on a non zero tx code.
+func broadcast(client Broadcaster, msgs []sdk.Msg) (*txtypes.BroadcastTxResponse, error) {
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("nothing to broadcast")
+	}
+
+	res, err := client.SyncBroadcastMsg(msgs...)
+	if err != nil {
+		return nil, fmt.Errorf("broadcast err: %w", err)
+	}
+	if res.TxResponse.Code != 0 {
+		return res, fmt.Errorf("tx failed with code %d: %s", res.TxResponse.Code, res.TxResponse.RawLog)
+	}
+	return res, nil
+}
@@ -0,0 +1,218 @@
+package strategy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	strategytypes "github.com/FluxNFTLabs/sdk-go/chain/modules/strategy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// Broadcaster is the part of chain.ChainClient used by Deployer.
+type Broadcaster interface {
+	FromAddress() sdk.AccAddress
+	SyncBroadcastMsg(msgs ...sdk.Msg) (*txtypes.BroadcastTxResponse, error)
+}
+
+type DeployAction string
+
+const (
+	// ActionDeployed means the code was not deployed by the sender yet
+	ActionDeployed DeployAction = "deployed"
+	// ActionUpdated means the code was deployed, its config was updated from the manifest
+	ActionUpdated DeployAction = "updated"
+	// ActionUnchanged means the code was deployed with the same config
+	ActionUnchanged DeployAction = "unchanged"
+)
+
+type DeployResult struct {
+	Id       string
+	Checksum []byte
+	Action   DeployAction
+	// TxHash is empty when unchanged
+	TxHash string
+}
+
+// CodeChecksum returns the checksum the chain records for a strategy binary.
+func CodeChecksum(code []byte) []byte {
+	sum := sha256.Sum256(code)
+	return sum[:]
+}
+
+// Deployer deploys strategies from manifests, idempotently: a manifest whose code is
+// already deployed by the sender only updates the strategy config.
+type Deployer struct {
+	client      Broadcaster
+	queryClient strategytypes.QueryClient
+}
+
+func NewDeployer(client Broadcaster, queryClient strategytypes.QueryClient) *Deployer {
+	return &Deployer{
+		client:      client,
+		queryClient: queryClient,
+	}
+}
+
+// FindDeployed returns the live strategy of the sender with the code checksum, nil if none.
+func (d *Deployer) FindDeployed(ctx context.Context, checksum []byte) (*strategytypes.Strategy, error) {
+	res, err := d.queryClient.ListStrategiesByOwner(ctx, &strategytypes.ListStrategiesByOwnerRequest{
+		Owner: d.client.FromAddress().String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list strategies err: %w", err)
+	}
+
+	for _, s := range res.Strategies {
+		if s.Deleted == 0 && bytes.Equal(s.CodeChecksum, checksum) {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+// Plan builds the message deploying or updating the manifest, nil when the deployed
+// strategy already matches it.
+func (d *Deployer) Plan(ctx context.Context, m *Manifest) (msg *strategytypes.MsgConfigStrategy, existing *strategytypes.Strategy, err error) {
+	msg, err = m.DeployMsg(d.client.FromAddress())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existing, err = d.FindDeployed(ctx, CodeChecksum(msg.Strategy))
+	if err != nil || existing == nil {
+		return msg, nil, err
+	}
+
+	if sameConfig(existing, msg) {
+		return nil, existing, nil
+	}
+
+	update := &strategytypes.MsgConfigStrategy{
+		Sender:            msg.Sender,
+		Config:            strategytypes.Config_update,
+		Id:                hex.EncodeToString(existing.Id),
+		Query:             msg.Query,
+		TriggerPermission: msg.TriggerPermission,
+		Metadata:          msg.Metadata,
+	}
+	if err := update.ValidateBasic(); err != nil {
+		return nil, nil, err
+	}
+	return update, existing, nil
+}
+
+// Deploy deploys the manifest, or updates the config of the strategy already running its code.
+func (d *Deployer) Deploy(ctx context.Context, m *Manifest) (*DeployResult, error) {
+	msg, existing, err := d.Plan(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg == nil {
+		return &DeployResult{
+			Id:       hex.EncodeToString(existing.Id),
+			Checksum: existing.CodeChecksum,
+			Action:   ActionUnchanged,
+		}, nil
+	}
+
+	res, err := d.client.SyncBroadcastMsg(msg)
+	if err != nil {
+		return nil, fmt.Errorf("broadcast %s err: %w", msg.Config, err)
+	}
+	if res.TxResponse.Code != 0 {
+		return nil, fmt.Errorf("%s strategy failed with code %d: %s", msg.Config, res.TxResponse.Code, res.TxResponse.RawLog)
+	}
+
+	if existing != nil {
+		return &DeployResult{
+			Id:       msg.Id,
+			Checksum: existing.CodeChecksum,
+			Action:   ActionUpdated,
+			TxHash:   res.TxResponse.TxHash,
+		}, nil
+	}
+
+	id, err := DecodeStrategyId(res)
+	if err != nil {
+		return nil, err
+	}
+	return &DeployResult{
+		Id:       id,
+		Checksum: CodeChecksum(msg.Strategy),
+		Action:   ActionDeployed,
+		TxHash:   res.TxResponse.TxHash,
+	}, nil
+}
+
+// DecodeStrategyId returns the id of the strategy deployed by the first message of the tx.
+func DecodeStrategyId(res *txtypes.BroadcastTxResponse) (string, error) {
+	data, err := hex.DecodeString(res.TxResponse.Data)
+	if err != nil {
+		return "", fmt.Errorf("decode tx data err: %w", err)
+	}
+
+	var txData sdk.TxMsgData
+	if err := txData.Unmarshal(data); err != nil {
+		return "", fmt.Errorf("unmarshal tx data err: %w", err)
+	}
+	if len(txData.MsgResponses) == 0 {
+		return "", fmt.Errorf("tx %s has no msg response", res.TxResponse.TxHash)
+	}
+
+	var response strategytypes.MsgConfigStrategyResponse
+	if err := response.Unmarshal(txData.MsgResponses[0].Value); err != nil {
+		return "", fmt.Errorf("unmarshal config strategy response err: %w", err)
+	}
+	return response.Id, nil
+}
+
+// sameConfig compares the deployed config with msg, ignoring the fields set by the chain.
+// Messages are compared encoded, so nil and empty values are equal.
+func sameConfig(s *strategytypes.Strategy, msg *strategytypes.MsgConfigStrategy) bool {
+	if !sameEncoding(s.Query, msg.Query) || !sameEncoding(s.TriggerPermission, msg.TriggerPermission) {
+		return false
+	}
+	if s.Metadata == nil || msg.Metadata == nil {
+		return s.Metadata == msg.Metadata
+	}
+
+	deployed := *s.Metadata
+	deployed.AggregatedQueryKeys = nil
+	deployed.SupportedApps = nil
+	wanted := *msg.Metadata
+	wanted.SupportedApps = nil
+	if !sameEncoding(&deployed, &wanted) || len(s.Metadata.SupportedApps) != len(msg.Metadata.SupportedApps) {
+		return false
+	}
+
+	// verification is granted on chain, only compare the apps
+	for i, app := range s.Metadata.SupportedApps {
+		want := msg.Metadata.SupportedApps[i]
+		if app.Name != want.Name || app.ContractAddress != want.ContractAddress || app.Plane != want.Plane {
+			return false
+		}
+	}
+	return true
+}
+
+func sameEncoding[T any, PT interface {
+	*T
+	proto.Message
+}](a, b PT) bool {
+	if a == nil {
+		a = new(T)
+	}
+	if b == nil {
+		b = new(T)
+	}
+
+	abz, aErr := proto.Marshal(a)
+	bbz, bErr := proto.Marshal(b)
+	return aErr == nil && bErr == nil && bytes.Equal(abz, bbz)
+}
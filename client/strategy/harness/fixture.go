@@ -0,0 +1,78 @@
+package harness
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	strategytypes "github.com/FluxNFTLabs/sdk-go/chain/modules/strategy/types"
+	"github.com/goccy/go-json"
+)
+
+// Fixture is a recorded strategy trigger: the input msg and the FIS query results the
+// chain feeds the strategy with. Fixtures are stored as json so they can be checked in
+// next to the tests using them.
+type Fixture struct {
+	Msg      json.RawMessage                  `json:"msg"`
+	Query    *astromeshtypes.FISQueryRequest  `json:"query,omitempty"`
+	Response *astromeshtypes.FISQueryResponse `json:"response"`
+}
+
+// NewInput builds the strategy input of msg, each instruction response of the FIS
+// query response becoming one FIS input.
+func NewInput(msg []byte, response *astromeshtypes.FISQueryResponse) *strategytypes.StrategyInput {
+	input := &strategytypes.StrategyInput{
+		Msg:      msg,
+		FisInput: []*strategytypes.FISInput{},
+	}
+	if response == nil {
+		return input
+	}
+
+	for _, ixRes := range response.InstructionResponses {
+		input.FisInput = append(input.FisInput, &strategytypes.FISInput{Data: ixRes.Output})
+	}
+	return input
+}
+
+// Record runs the FIS query of a trigger against a node, recording the fixture of msg.
+func Record(ctx context.Context, queryClient astromeshtypes.QueryClient, msg []byte, query *astromeshtypes.FISQueryRequest) (*Fixture, error) {
+	res, err := queryClient.FISQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("fis query err: %w", err)
+	}
+
+	return &Fixture{
+		Msg:      msg,
+		Query:    query,
+		Response: res,
+	}, nil
+}
+
+// LoadFixture reads a fixture saved by Fixture.Save.
+func LoadFixture(path string) (*Fixture, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read fixture err: %w", err)
+	}
+
+	f := &Fixture{}
+	if err := json.Unmarshal(bz, f); err != nil {
+		return nil, fmt.Errorf("unmarshal fixture %s err: %w", path, err)
+	}
+	return f, nil
+}
+
+func (f *Fixture) Save(path string) error {
+	bz, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal fixture err: %w", err)
+	}
+	return os.WriteFile(path, bz, 0o644)
+}
+
+// Input is the strategy input of the fixture.
+func (f *Fixture) Input() *strategytypes.StrategyInput {
+	return NewInput(f.Msg, f.Response)
+}
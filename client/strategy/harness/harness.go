@@ -0,0 +1,314 @@
+// Package harness runs strategy wasm without a node, so solvers can be covered by Go
+// table tests:
+//
+//	h, err := harness.NewFromFile(ctx, "staking_solver.wasm")
+//	...
+//	fixture, err := harness.LoadFixture("testdata/delegate.json")
+//	...
+//	res, err := h.Run(ctx, fixture.Input())
+//	// res.Msgs[0] is the *stakingtypes.MsgDelegate built by the solver
+//
+// Fixtures are recorded from a node with Record, or written by hand.
+package harness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	strategytypes "github.com/FluxNFTLabs/sdk-go/chain/modules/strategy/types"
+	chaintypes "github.com/FluxNFTLabs/sdk-go/chain/types"
+	"github.com/FluxNFTLabs/sdk-go/client/fis"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/goccy/go-json"
+	"github.com/tetratelabs/wazero"
+)
+
+// Querier answers the cosmwasm QueryRequest json of a contract with the query
+// response bytes, an error is returned to the contract as a failed query.
+type Querier func(ctx context.Context, request []byte) ([]byte, error)
+
+// Env is the block and contract environment seen by the strategy.
+type Env struct {
+	ChainId  string
+	Height   uint64
+	Time     time.Time
+	Contract sdk.AccAddress
+}
+
+type HarnessOptions struct {
+	Env     Env
+	Querier Querier
+	// Debug receives the messages of deps.api.debug
+	Debug func(msg string)
+	// Codec decodes the produced instructions, defaults to the chain codec
+	Codec codec.Codec
+}
+
+type HarnessOption func(opts *HarnessOptions) error
+
+func DefaultHarnessOptions() *HarnessOptions {
+	return &HarnessOptions{
+		Env: Env{
+			ChainId:  "flux-1",
+			Height:   1,
+			Time:     time.Unix(1_700_000_000, 0),
+			Contract: make(sdk.AccAddress, 32),
+		},
+	}
+}
+
+func OptionEnv(env Env) HarnessOption {
+	return func(opts *HarnessOptions) error {
+		opts.Env = env
+		return nil
+	}
+}
+
+func OptionQuerier(querier Querier) HarnessOption {
+	return func(opts *HarnessOptions) error {
+		opts.Querier = querier
+		return nil
+	}
+}
+
+func OptionDebug(fn func(msg string)) HarnessOption {
+	return func(opts *HarnessOptions) error {
+		opts.Debug = fn
+		return nil
+	}
+}
+
+func OptionCodec(cdc codec.Codec) HarnessOption {
+	return func(opts *HarnessOptions) error {
+		opts.Codec = cdc
+		return nil
+	}
+}
+
+// Harness runs strategy wasm in a pure Go runtime, the way the chain does on
+// MsgTriggerStrategies, without a node. Every Run uses a fresh instance sharing the
+// harness Store.
+type Harness struct {
+	opts     *HarnessOptions
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	store    *Store
+}
+
+// New compiles a strategy binary.
+func New(ctx context.Context, code []byte, options ...HarnessOption) (*Harness, error) {
+	opts := DefaultHarnessOptions()
+	for _, opt := range options {
+		if err := opt(opts); err != nil {
+			return nil, fmt.Errorf("error in harness option: %w", err)
+		}
+	}
+
+	if opts.Codec == nil {
+		clientCtx, _, err := chaintypes.NewClientContext(opts.Env.ChainId, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		opts.Codec = clientCtx.Codec
+	}
+
+	r := wazero.NewRuntime(ctx)
+	if err := instantiateEnv(ctx, r); err != nil {
+		r.Close(ctx)
+		return nil, fmt.Errorf("instantiate host env err: %w", err)
+	}
+
+	compiled, err := r.CompileModule(ctx, code)
+	if err != nil {
+		r.Close(ctx)
+		return nil, fmt.Errorf("compile strategy err: %w", err)
+	}
+
+	return &Harness{
+		opts:     opts,
+		runtime:  r,
+		compiled: compiled,
+		store:    NewStore(),
+	}, nil
+}
+
+// NewFromFile compiles the strategy binary at path.
+func NewFromFile(ctx context.Context, path string, options ...HarnessOption) (*Harness, error) {
+	code, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read strategy err: %w", err)
+	}
+	return New(ctx, code, options...)
+}
+
+func (h *Harness) Close(ctx context.Context) error {
+	return h.runtime.Close(ctx)
+}
+
+// Store is the contract storage shared by all runs.
+func (h *Harness) Store() *Store {
+	return h.store
+}
+
+// Result is the output of a strategy run with its instructions decoded.
+type Result struct {
+	Output *strategytypes.StrategyOutput
+	// Msgs are the decoded messages of Output.Instructions
+	Msgs []sdk.Msg
+	// Raw is the output json returned by the strategy
+	Raw []byte
+}
+
+// Run executes the strategy on input like MsgTriggerStrategies does and decodes its output.
+func (h *Harness) Run(ctx context.Context, input *strategytypes.StrategyInput) (*Result, error) {
+	msg, err := json.Marshal(newStrategyInputJSON(input))
+	if err != nil {
+		return nil, fmt.Errorf("marshal strategy input err: %w", err)
+	}
+
+	raw, err := h.Query(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &strategytypes.StrategyOutput{}
+	if err := h.opts.Codec.UnmarshalJSON(raw, output); err != nil {
+		return nil, fmt.Errorf("unmarshal strategy output %s err: %w", raw, err)
+	}
+
+	res := &Result{Output: output, Raw: raw}
+	for i, ix := range output.Instructions {
+		msg, err := fis.DecodeInstruction(h.opts.Codec, ix)
+		if err != nil {
+			return res, fmt.Errorf("decode instruction %d err: %w", i, err)
+		}
+		res.Msgs = append(res.Msgs, msg)
+	}
+	return res, nil
+}
+
+// Query calls the query entry point with a raw json msg, returning the contract response.
+func (h *Harness) Query(ctx context.Context, msg []byte) ([]byte, error) {
+	env, err := h.envJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.call(ctx, true, "query", env, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var res contractResult
+	if err := json.Unmarshal(out, &res); err != nil {
+		return nil, fmt.Errorf("unmarshal query result err: %w", err)
+	}
+	if res.Error != "" {
+		return nil, fmt.Errorf("strategy error: %s", res.Error)
+	}
+	return res.Ok, nil
+}
+
+// strategyInputJSON is the serde encoding of StrategyInput, the contracts require every field.
+type strategyInputJSON struct {
+	Msg      []byte         `json:"msg"`
+	FisInput []fisInputJSON `json:"fis_input"`
+}
+
+type fisInputJSON struct {
+	Data [][]byte `json:"data"`
+}
+
+func newStrategyInputJSON(input *strategytypes.StrategyInput) *strategyInputJSON {
+	res := &strategyInputJSON{
+		Msg:      input.Msg,
+		FisInput: make([]fisInputJSON, len(input.FisInput)),
+	}
+	if res.Msg == nil {
+		res.Msg = []byte{}
+	}
+	for i, fisInput := range input.FisInput {
+		res.FisInput[i].Data = fisInput.Data
+		if res.FisInput[i].Data == nil {
+			res.FisInput[i].Data = [][]byte{}
+		}
+	}
+	return res
+}
+
+type cosmwasmEnv struct {
+	Block struct {
+		Height  uint64 `json:"height"`
+		Time    string `json:"time"`
+		ChainId string `json:"chain_id"`
+	} `json:"block"`
+	Transaction *struct {
+		Index uint32 `json:"index"`
+	} `json:"transaction"`
+	Contract struct {
+		Address string `json:"address"`
+	} `json:"contract"`
+}
+
+func (h *Harness) envJSON() ([]byte, error) {
+	var env cosmwasmEnv
+	env.Block.Height = h.opts.Env.Height
+	env.Block.Time = strconv.FormatInt(h.opts.Env.Time.UnixNano(), 10)
+	env.Block.ChainId = h.opts.Env.ChainId
+	env.Contract.Address = h.opts.Env.Contract.String()
+	return json.Marshal(env)
+}
+
+// call instantiates the contract and calls an entry point with json args, returning the
+// json result.
+func (h *Harness) call(ctx context.Context, readOnly bool, entryPoint string, args ...[]byte) (out []byte, err error) {
+	ctx = context.WithValue(ctx, instanceKey{}, &instance{
+		store:    h.store,
+		readOnly: readOnly,
+		querier:  h.opts.Querier,
+		debug:    h.opts.Debug,
+	})
+
+	m, err := h.runtime.InstantiateModule(ctx, h.compiled, wazero.NewModuleConfig().WithName(""))
+	if err != nil {
+		return nil, fmt.Errorf("instantiate strategy err: %w", err)
+	}
+	defer m.Close(ctx)
+
+	// host functions abort with a hostError, wazero recovers it into the call error
+	defer func() {
+		if r := recover(); r != nil {
+			herr, ok := r.(hostError)
+			if !ok {
+				panic(r)
+			}
+			err = herr
+		}
+
+		var herr hostError
+		if errors.As(err, &herr) {
+			err = fmt.Errorf("%s aborted: %w", entryPoint, herr.err)
+		}
+	}()
+
+	fn := m.ExportedFunction(entryPoint)
+	if fn == nil {
+		return nil, fmt.Errorf("strategy has no %s entry point", entryPoint)
+	}
+
+	params := make([]uint64, len(args))
+	for i, arg := range args {
+		params[i] = uint64(allocateRegion(ctx, m, arg))
+	}
+
+	res, err := fn.Call(ctx, params...)
+	if err != nil {
+		return nil, fmt.Errorf("%s err: %w", entryPoint, err)
+	}
+	return readRegion(m, uint32(res[0])), nil
+}
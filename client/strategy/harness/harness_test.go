@@ -0,0 +1,56 @@
+package harness
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+// cronWasm sends the amount of the cron input from the strategy contract to the receiver.
+const cronWasm = "../../../examples/chain/26_MsgConfigCron/cron.wasm"
+
+func TestRunFixture(t *testing.T) {
+	ctx := context.Background()
+	contract := sdk.AccAddress(make([]byte, 32))
+	contract[31] = 1
+
+	h, err := NewFromFile(ctx, cronWasm, OptionEnv(Env{
+		ChainId:  "flux-1",
+		Height:   100,
+		Time:     time.Unix(1_700_000_000, 0),
+		Contract: contract,
+	}))
+	require.NoError(t, err)
+	defer h.Close(ctx)
+
+	fixture, err := LoadFixture("testdata/cron_send.json")
+	require.NoError(t, err)
+
+	res, err := h.Run(ctx, fixture.Input())
+	require.NoError(t, err)
+	require.Len(t, res.Output.Instructions, 1)
+	require.Equal(t, astromeshtypes.Plane_COSMOS, res.Output.Instructions[0].Plane)
+	require.Equal(t, astromeshtypes.TxAction_COSMOS_BANK_SEND, res.Output.Instructions[0].Action)
+
+	require.Len(t, res.Msgs, 1)
+	require.Equal(t, &banktypes.MsgSend{
+		FromAddress: contract.String(),
+		ToAddress:   "lux158ucxjzr6ccrlpmz8z05wylu8tr5eueqcp2afu",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("lux", 1)),
+	}, res.Msgs[0])
+}
+
+func TestRunInvalidInput(t *testing.T) {
+	ctx := context.Background()
+	h, err := NewFromFile(ctx, cronWasm)
+	require.NoError(t, err)
+	defer h.Close(ctx)
+
+	_, err = h.Run(ctx, NewInput([]byte(`{"receiver":1}`), nil))
+	require.ErrorContains(t, err, "strategy error")
+}
@@ -0,0 +1,399 @@
+package harness
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/goccy/go-json"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+)
+
+// cosmwasm crypto api results
+const (
+	cryptoValid          = 0
+	cryptoInvalid        = 1
+	cryptoGenericErr     = 10
+	cryptoInvalidHashErr = 3
+)
+
+// instance is the host state of a single contract call, host functions find it in the
+// call context.
+type instance struct {
+	store    *Store
+	readOnly bool
+	querier  Querier
+	debug    func(msg string)
+
+	iterators [][]kv
+}
+
+type kv struct {
+	key, value []byte
+}
+
+type instanceKey struct{}
+
+func instanceOf(ctx context.Context) *instance {
+	return ctx.Value(instanceKey{}).(*instance)
+}
+
+// hostError aborts the contract call, like a VM error of wasmvm.
+type hostError struct {
+	err error
+}
+
+func (e hostError) Error() string {
+	return e.err.Error()
+}
+
+func abort(format string, args ...interface{}) {
+	panic(hostError{err: fmt.Errorf(format, args...)})
+}
+
+// readRegion copies the data of the cosmwasm Region at ptr.
+func readRegion(m api.Module, ptr uint32) []byte {
+	mem := m.Memory()
+	offset, ok1 := mem.ReadUint32Le(ptr)
+	length, ok2 := mem.ReadUint32Le(ptr + 8)
+	if !ok1 || !ok2 {
+		abort("region %d out of memory", ptr)
+	}
+
+	data, ok := mem.Read(offset, length)
+	if !ok {
+		abort("region %d data out of memory", ptr)
+	}
+	return bytes.Clone(data)
+}
+
+// allocateRegion allocates a Region in the contract memory holding data.
+func allocateRegion(ctx context.Context, m api.Module, data []byte) uint32 {
+	res, err := m.ExportedFunction("allocate").Call(ctx, uint64(len(data)))
+	if err != nil {
+		abort("allocate err: %w", err)
+	}
+
+	ptr := uint32(res[0])
+	writeRegion(m, ptr, data)
+	return ptr
+}
+
+// writeRegion writes data into the Region at ptr allocated by the contract.
+func writeRegion(m api.Module, ptr uint32, data []byte) {
+	mem := m.Memory()
+	offset, ok1 := mem.ReadUint32Le(ptr)
+	capacity, ok2 := mem.ReadUint32Le(ptr + 4)
+	if !ok1 || !ok2 {
+		abort("region %d out of memory", ptr)
+	}
+	if uint32(len(data)) > capacity {
+		abort("region %d capacity %d is too small for %d bytes", ptr, capacity, len(data))
+	}
+
+	if !mem.Write(offset, data) || !mem.WriteUint32Le(ptr+8, uint32(len(data))) {
+		abort("write region %d out of memory", ptr)
+	}
+}
+
+// encodeSections encodes sections as section || len(section) with 4 bytes big endian
+// lengths, the format of iterator entries and batch verification inputs.
+func encodeSections(sections ...[]byte) []byte {
+	var buf bytes.Buffer
+	for _, s := range sections {
+		buf.Write(s)
+		_ = binary.Write(&buf, binary.BigEndian, uint32(len(s)))
+	}
+	return buf.Bytes()
+}
+
+func decodeSections(data []byte) [][]byte {
+	var sections [][]byte
+	for len(data) > 0 {
+		if len(data) < 4 {
+			abort("invalid sections encoding")
+		}
+		l := binary.BigEndian.Uint32(data[len(data)-4:])
+		data = data[:len(data)-4]
+		if uint32(len(data)) < l {
+			abort("invalid sections encoding")
+		}
+		sections = append([][]byte{data[uint32(len(data))-l:]}, sections...)
+		data = data[:uint32(len(data))-l]
+	}
+	return sections
+}
+
+// instantiateEnv registers the cosmwasm "env" host module.
+func instantiateEnv(ctx context.Context, r wazero.Runtime) error {
+	_, err := r.NewHostModuleBuilder("env").
+		NewFunctionBuilder().WithFunc(func(ctx context.Context, m api.Module, msgPtr uint32) {
+		abort("contract aborted: %s", readRegion(m, msgPtr))
+	}).Export("abort").
+		NewFunctionBuilder().WithFunc(dbRead).Export("db_read").
+		NewFunctionBuilder().WithFunc(dbWrite).Export("db_write").
+		NewFunctionBuilder().WithFunc(dbRemove).Export("db_remove").
+		NewFunctionBuilder().WithFunc(dbScan).Export("db_scan").
+		NewFunctionBuilder().WithFunc(dbNext).Export("db_next").
+		NewFunctionBuilder().WithFunc(addrValidate).Export("addr_validate").
+		NewFunctionBuilder().WithFunc(addrCanonicalize).Export("addr_canonicalize").
+		NewFunctionBuilder().WithFunc(addrHumanize).Export("addr_humanize").
+		NewFunctionBuilder().WithFunc(secp256k1Verify).Export("secp256k1_verify").
+		NewFunctionBuilder().WithFunc(secp256k1RecoverPubkey).Export("secp256k1_recover_pubkey").
+		NewFunctionBuilder().WithFunc(ed25519Verify).Export("ed25519_verify").
+		NewFunctionBuilder().WithFunc(ed25519BatchVerify).Export("ed25519_batch_verify").
+		NewFunctionBuilder().WithFunc(func(ctx context.Context, m api.Module, msgPtr uint32) {
+		if debug := instanceOf(ctx).debug; debug != nil {
+			debug(string(readRegion(m, msgPtr)))
+		}
+	}).Export("debug").
+		NewFunctionBuilder().WithFunc(queryChain).Export("query_chain").
+		Instantiate(ctx)
+	return err
+}
+
+func dbRead(ctx context.Context, m api.Module, keyPtr uint32) uint32 {
+	value, ok := instanceOf(ctx).store.Get(readRegion(m, keyPtr))
+	if !ok {
+		return 0
+	}
+	return allocateRegion(ctx, m, value)
+}
+
+func dbWrite(ctx context.Context, m api.Module, keyPtr, valuePtr uint32) {
+	inst := instanceOf(ctx)
+	if inst.readOnly {
+		abort("storage is read only in queries")
+	}
+	inst.store.Set(readRegion(m, keyPtr), readRegion(m, valuePtr))
+}
+
+func dbRemove(ctx context.Context, m api.Module, keyPtr uint32) {
+	inst := instanceOf(ctx)
+	if inst.readOnly {
+		abort("storage is read only in queries")
+	}
+	inst.store.Delete(readRegion(m, keyPtr))
+}
+
+func dbScan(ctx context.Context, m api.Module, startPtr, endPtr uint32, order int32) uint32 {
+	var start, end []byte
+	if startPtr != 0 {
+		start = readRegion(m, startPtr)
+	}
+	if endPtr != 0 {
+		end = readRegion(m, endPtr)
+	}
+
+	// 1 is ascending, 2 descending
+	if order != 1 && order != 2 {
+		abort("invalid iteration order %d", order)
+	}
+
+	inst := instanceOf(ctx)
+	entries := inst.store.Range(start, end, order == 2)
+	inst.iterators = append(inst.iterators, entries)
+	return uint32(len(inst.iterators))
+}
+
+func dbNext(ctx context.Context, m api.Module, iteratorId uint32) uint32 {
+	inst := instanceOf(ctx)
+	if iteratorId == 0 || int(iteratorId) > len(inst.iterators) {
+		abort("iterator %d does not exist", iteratorId)
+	}
+
+	it := inst.iterators[iteratorId-1]
+	if len(it) == 0 {
+		return allocateRegion(ctx, m, encodeSections(nil, nil))
+	}
+	inst.iterators[iteratorId-1] = it[1:]
+	return allocateRegion(ctx, m, encodeSections(it[0].key, it[0].value))
+}
+
+func addrValidate(ctx context.Context, m api.Module, srcPtr uint32) uint32 {
+	addr := string(readRegion(m, srcPtr))
+	canonical, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return allocateRegion(ctx, m, []byte(err.Error()))
+	}
+	if canonical.String() != addr {
+		return allocateRegion(ctx, m, []byte("address is not normalized"))
+	}
+	return 0
+}
+
+func addrCanonicalize(ctx context.Context, m api.Module, srcPtr, dstPtr uint32) uint32 {
+	canonical, err := sdk.AccAddressFromBech32(string(readRegion(m, srcPtr)))
+	if err != nil {
+		return allocateRegion(ctx, m, []byte(err.Error()))
+	}
+	writeRegion(m, dstPtr, canonical)
+	return 0
+}
+
+func addrHumanize(ctx context.Context, m api.Module, srcPtr, dstPtr uint32) uint32 {
+	canonical := readRegion(m, srcPtr)
+	if err := sdk.VerifyAddressFormat(canonical); err != nil {
+		return allocateRegion(ctx, m, []byte(err.Error()))
+	}
+	writeRegion(m, dstPtr, []byte(sdk.AccAddress(canonical).String()))
+	return 0
+}
+
+func secp256k1Verify(ctx context.Context, m api.Module, hashPtr, sigPtr, pubkeyPtr uint32) uint32 {
+	hash, sig, pubkey := readRegion(m, hashPtr), readRegion(m, sigPtr), readRegion(m, pubkeyPtr)
+	if len(hash) != 32 {
+		return cryptoInvalidHashErr
+	}
+	if len(sig) != 64 {
+		return cryptoGenericErr
+	}
+	if crypto.VerifySignature(pubkey, hash, sig) {
+		return cryptoValid
+	}
+	return cryptoInvalid
+}
+
+func secp256k1RecoverPubkey(ctx context.Context, m api.Module, hashPtr, sigPtr uint32, recoveryParam uint32) uint64 {
+	hash, sig := readRegion(m, hashPtr), readRegion(m, sigPtr)
+	if len(hash) != 32 {
+		return cryptoInvalidHashErr << 32
+	}
+	if len(sig) != 64 || recoveryParam > 1 {
+		return cryptoGenericErr << 32
+	}
+
+	pubkey, err := crypto.Ecrecover(hash, append(sig, byte(recoveryParam)))
+	if err != nil {
+		return cryptoGenericErr << 32
+	}
+	return uint64(allocateRegion(ctx, m, pubkey))
+}
+
+func ed25519Verify(ctx context.Context, m api.Module, msgPtr, sigPtr, pubkeyPtr uint32) uint32 {
+	msg, sig, pubkey := readRegion(m, msgPtr), readRegion(m, sigPtr), readRegion(m, pubkeyPtr)
+	if len(pubkey) != ed25519.PublicKeySize || len(sig) != ed25519.SignatureSize {
+		return cryptoGenericErr
+	}
+	if ed25519.Verify(pubkey, msg, sig) {
+		return cryptoValid
+	}
+	return cryptoInvalid
+}
+
+func ed25519BatchVerify(ctx context.Context, m api.Module, msgsPtr, sigsPtr, pubkeysPtr uint32) uint32 {
+	msgs := decodeSections(readRegion(m, msgsPtr))
+	sigs := decodeSections(readRegion(m, sigsPtr))
+	pubkeys := decodeSections(readRegion(m, pubkeysPtr))
+
+	// a single message or public key applies to all signatures
+	n := len(sigs)
+	if (len(msgs) != n && len(msgs) != 1) || (len(pubkeys) != n && len(pubkeys) != 1) {
+		return cryptoGenericErr
+	}
+
+	for i := 0; i < n; i++ {
+		msg, pubkey := msgs[0], pubkeys[0]
+		if len(msgs) > 1 {
+			msg = msgs[i]
+		}
+		if len(pubkeys) > 1 {
+			pubkey = pubkeys[i]
+		}
+		if len(pubkey) != ed25519.PublicKeySize || len(sigs[i]) != ed25519.SignatureSize {
+			return cryptoGenericErr
+		}
+		if !ed25519.Verify(pubkey, msg, sigs[i]) {
+			return cryptoInvalid
+		}
+	}
+	return cryptoValid
+}
+
+// systemResult is cosmwasm SystemResult<ContractResult<Binary>>
+type systemResult struct {
+	Ok    *contractResult        `json:"ok,omitempty"`
+	Error map[string]interface{} `json:"error,omitempty"`
+}
+
+type contractResult struct {
+	Ok    []byte `json:"ok,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func queryChain(ctx context.Context, m api.Module, requestPtr uint32) uint32 {
+	request := readRegion(m, requestPtr)
+
+	var res systemResult
+	querier := instanceOf(ctx).querier
+	if querier == nil {
+		res.Error = map[string]interface{}{
+			"unsupported_request": map[string]string{"kind": "no querier configured"},
+		}
+	} else {
+		out, err := querier(ctx, request)
+		if err != nil {
+			res.Ok = &contractResult{Error: err.Error()}
+		} else {
+			res.Ok = &contractResult{Ok: out}
+		}
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		abort("marshal query result err: %w", err)
+	}
+	return allocateRegion(ctx, m, bz)
+}
+
+// Store is the in-memory contract storage.
+type Store struct {
+	data map[string][]byte
+}
+
+func NewStore() *Store {
+	return &Store{data: map[string][]byte{}}
+}
+
+func (s *Store) Get(key []byte) ([]byte, bool) {
+	v, ok := s.data[string(key)]
+	return v, ok
+}
+
+func (s *Store) Set(key, value []byte) {
+	s.data[string(key)] = bytes.Clone(value)
+}
+
+func (s *Store) Delete(key []byte) {
+	delete(s.data, string(key))
+}
+
+// Range returns the entries in [start, end), nil bounds are unbounded.
+func (s *Store) Range(start, end []byte, reverse bool) []kv {
+	var entries []kv
+	for k, v := range s.data {
+		key := []byte(k)
+		if start != nil && bytes.Compare(key, start) < 0 {
+			continue
+		}
+		if end != nil && bytes.Compare(key, end) >= 0 {
+			continue
+		}
+		entries = append(entries, kv{key: key, value: v})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		less := bytes.Compare(entries[i].key, entries[j].key) < 0
+		if reverse {
+			return !less
+		}
+		return less
+	})
+	return entries
+}
@@ -0,0 +1,356 @@
+package strategy
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	strategytypes "github.com/FluxNFTLabs/sdk-go/chain/modules/strategy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gagliardetto/solana-go"
+	"gopkg.in/yaml.v2"
+)
+
+// Manifest declares a strategy deployment. It is loaded from yaml or json, ${VAR}
+// references to set environment variables are expanded so the same file can be
+// promoted between networks, e.g.
+//
+//	name: Bank Cron Demo
+//	type: CRON
+//	code: ./cron.wasm
+//	tags: [cron, bank]
+//	cron:
+//	  gas_price: "500000000"
+//	  input: '{"receiver":"${RECEIVER}","amount":"1","denom":"lux"}'
+//	  interval: 2
+//	trigger_permission:
+//	  type: only_addresses
+//	  addresses: ["${OWNER}"]
+//	queries:
+//	  - plane: COSMOS
+//	    action: COSMOS_KVSTORE
+//	    input: ["wasm", "hex:046c617374436f6e74726163744964"]
+type Manifest struct {
+	Name        string   `yaml:"name" json:"name"`
+	Description string   `yaml:"description" json:"description"`
+	Logo        string   `yaml:"logo" json:"logo"`
+	Website     string   `yaml:"website" json:"website"`
+	Type        string   `yaml:"type" json:"type"`
+	Tags        []string `yaml:"tags" json:"tags"`
+	// Code is the path of the strategy wasm, relative to the manifest
+	Code string `yaml:"code" json:"code"`
+	// Schema is the inline intent schema, SchemaFile a path to it relative to the manifest
+	Schema            string                 `yaml:"schema" json:"schema"`
+	SchemaFile        string                 `yaml:"schema_file" json:"schema_file"`
+	Cron              *CronManifest          `yaml:"cron" json:"cron"`
+	SupportedApps     []SupportedAppManifest `yaml:"supported_apps" json:"supported_apps"`
+	TriggerPermission *PermissionManifest    `yaml:"trigger_permission" json:"trigger_permission"`
+	Queries           []QueryManifest        `yaml:"queries" json:"queries"`
+
+	// dir the relative paths are resolved from
+	dir string
+}
+
+type CronManifest struct {
+	GasPrice string `yaml:"gas_price" json:"gas_price"`
+	Input    string `yaml:"input" json:"input"`
+	// Interval in seconds, 0 triggers on the events of the COSMOS_EVENT queries
+	Interval uint64 `yaml:"interval" json:"interval"`
+}
+
+type SupportedAppManifest struct {
+	Name            string `yaml:"name" json:"name"`
+	ContractAddress string `yaml:"contract_address" json:"contract_address"`
+	Plane           string `yaml:"plane" json:"plane"`
+}
+
+type PermissionManifest struct {
+	Type      string   `yaml:"type" json:"type"`
+	Addresses []string `yaml:"addresses" json:"addresses"`
+}
+
+// QueryManifest is a FIS query instruction. Address and input strings are used as
+// is unless prefixed with "hex:" or "base64:".
+type QueryManifest struct {
+	Plane   string   `yaml:"plane" json:"plane"`
+	Action  string   `yaml:"action" json:"action"`
+	Address string   `yaml:"address" json:"address"`
+	Input   []string `yaml:"input" json:"input"`
+}
+
+// LoadManifest reads the manifest at path.
+func LoadManifest(path string) (*Manifest, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest err: %w", err)
+	}
+
+	m, err := ParseManifest([]byte(expandEnv(string(bz))))
+	if err != nil {
+		return nil, fmt.Errorf("parse manifest %s err: %w", path, err)
+	}
+	m.dir = filepath.Dir(path)
+	return m, nil
+}
+
+// ParseManifest decodes a yaml or json manifest, relative paths are resolved from
+// the working directory.
+func ParseManifest(bz []byte) (*Manifest, error) {
+	m := &Manifest{}
+	if err := yaml.UnmarshalStrict(bz, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var envVarReg = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// expandEnv expands set environment variables, other references such as the
+// ${name:type} schema templates are kept.
+func expandEnv(s string) string {
+	return os.Expand(s, func(name string) string {
+		if envVarReg.MatchString(name) {
+			if v, ok := os.LookupEnv(name); ok {
+				return v
+			}
+		}
+		return "${" + name + "}"
+	})
+}
+
+func (m *Manifest) path(p string) string {
+	if filepath.IsAbs(p) || m.dir == "" {
+		return p
+	}
+	return filepath.Join(m.dir, p)
+}
+
+// LoadCode reads the strategy wasm.
+func (m *Manifest) LoadCode() ([]byte, error) {
+	if m.Code == "" {
+		return nil, fmt.Errorf("manifest has no code")
+	}
+
+	code, err := os.ReadFile(m.path(m.Code))
+	if err != nil {
+		return nil, fmt.Errorf("read strategy code err: %w", err)
+	}
+	return code, nil
+}
+
+// Metadata builds the strategy metadata and validates it against query like the chain does.
+func (m *Manifest) Metadata(query *astromeshtypes.FISQueryRequest) (*strategytypes.StrategyMetadata, error) {
+	strategyType, ok := strategytypes.StrategyType_value[m.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown strategy type %q", m.Type)
+	}
+
+	schema := m.Schema
+	if m.SchemaFile != "" {
+		if schema != "" {
+			return nil, fmt.Errorf("schema and schema_file are exclusive")
+		}
+		bz, err := os.ReadFile(m.path(m.SchemaFile))
+		if err != nil {
+			return nil, fmt.Errorf("read schema err: %w", err)
+		}
+		schema = string(bz)
+	}
+
+	metadata := &strategytypes.StrategyMetadata{
+		Name:        m.Name,
+		Description: m.Description,
+		Logo:        m.Logo,
+		Website:     m.Website,
+		Type:        strategytypes.StrategyType(strategyType),
+		Tags:        m.Tags,
+		Schema:      schema,
+	}
+
+	if m.Cron != nil {
+		if metadata.Type != strategytypes.StrategyType_CRON {
+			return nil, fmt.Errorf("cron settings are only allowed for CRON strategies")
+		}
+		gasPrice, ok := sdkmath.NewIntFromString(m.Cron.GasPrice)
+		if !ok {
+			return nil, fmt.Errorf("invalid cron gas price %q", m.Cron.GasPrice)
+		}
+		metadata.CronGasPrice = gasPrice
+		metadata.CronInput = m.Cron.Input
+		metadata.CronInterval = m.Cron.Interval
+	} else if metadata.Type == strategytypes.StrategyType_CRON {
+		return nil, fmt.Errorf("CRON strategies require cron settings")
+	}
+
+	for _, app := range m.SupportedApps {
+		plane, err := parsePlane(app.Plane)
+		if err != nil {
+			return nil, fmt.Errorf("supported app %s: %w", app.Name, err)
+		}
+		// ParseContractAddr panics on malformed bech32 and base58 addresses
+		if err := checkContractAddr(app.ContractAddress, plane); err != nil {
+			return nil, fmt.Errorf("supported app %s: %w", app.Name, err)
+		}
+		metadata.SupportedApps = append(metadata.SupportedApps, &strategytypes.SupportedApp{
+			Name:            app.Name,
+			ContractAddress: app.ContractAddress,
+			Plane:           plane,
+		})
+	}
+
+	if err := metadata.ValidateBasic(query, true); err != nil {
+		return nil, err
+	}
+
+	// the chain only checks the schema of intent solvers, check it for any type
+	if schema != "" && metadata.Type != strategytypes.StrategyType_INTENT_SOLVER {
+		schemaOnly := &strategytypes.StrategyMetadata{Type: strategytypes.StrategyType_INTENT_SOLVER, Schema: schema}
+		if err := schemaOnly.ValidateBasic(query, false); err != nil {
+			return nil, fmt.Errorf("invalid schema: %w", err)
+		}
+	}
+
+	return metadata, nil
+}
+
+// Query builds the FIS query request of the strategy.
+func (m *Manifest) Query() (*astromeshtypes.FISQueryRequest, error) {
+	query := &astromeshtypes.FISQueryRequest{
+		Instructions: []*astromeshtypes.FISQueryInstruction{},
+	}
+
+	for i, q := range m.Queries {
+		plane, err := parsePlane(q.Plane)
+		if err != nil {
+			return nil, fmt.Errorf("query %d: %w", i, err)
+		}
+
+		action, ok := astromeshtypes.QueryAction_value[q.Action]
+		if !ok {
+			return nil, fmt.Errorf("query %d: unknown action %q", i, q.Action)
+		}
+
+		ix := &astromeshtypes.FISQueryInstruction{
+			Plane:   plane,
+			Action:  astromeshtypes.QueryAction(action),
+			Address: []byte{},
+		}
+		if q.Address != "" {
+			ix.Address, err = decodeBytes(q.Address)
+			if err != nil {
+				return nil, fmt.Errorf("query %d address: %w", i, err)
+			}
+		}
+		for _, input := range q.Input {
+			bz, err := decodeBytes(input)
+			if err != nil {
+				return nil, fmt.Errorf("query %d input: %w", i, err)
+			}
+			ix.Input = append(ix.Input, bz)
+		}
+		query.Instructions = append(query.Instructions, ix)
+	}
+
+	return query, nil
+}
+
+// Permission builds the trigger permission, nil when the manifest has none.
+func (m *Manifest) Permission() (*strategytypes.PermissionConfig, error) {
+	if m.TriggerPermission == nil {
+		return nil, nil
+	}
+
+	accessType, ok := strategytypes.AccessType_value[m.TriggerPermission.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown trigger permission type %q", m.TriggerPermission.Type)
+	}
+
+	for _, addr := range m.TriggerPermission.Addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return nil, fmt.Errorf("invalid trigger permission address %q: %w", addr, err)
+		}
+	}
+
+	return &strategytypes.PermissionConfig{
+		Type:      strategytypes.AccessType(accessType),
+		Addresses: m.TriggerPermission.Addresses,
+	}, nil
+}
+
+// DeployMsg builds and validates the deploy message of the manifest.
+func (m *Manifest) DeployMsg(sender sdk.AccAddress) (*strategytypes.MsgConfigStrategy, error) {
+	code, err := m.LoadCode()
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := m.Query()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := m.Metadata(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metadata: %w", err)
+	}
+
+	permission, err := m.Permission()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &strategytypes.MsgConfigStrategy{
+		Sender:            sender.String(),
+		Config:            strategytypes.Config_deploy,
+		Strategy:          code,
+		Query:             query,
+		TriggerPermission: permission,
+		Metadata:          metadata,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func parsePlane(s string) (astromeshtypes.Plane, error) {
+	plane, ok := astromeshtypes.Plane_value[strings.ToUpper(s)]
+	if !ok {
+		return 0, fmt.Errorf("unknown plane %q", s)
+	}
+	return astromeshtypes.Plane(plane), nil
+}
+
+func checkContractAddr(addr string, plane astromeshtypes.Plane) error {
+	var err error
+	switch plane {
+	case astromeshtypes.Plane_WASM:
+		_, err = sdk.AccAddressFromBech32(addr)
+	case astromeshtypes.Plane_SVM:
+		_, err = solana.PublicKeyFromBase58(addr)
+	case astromeshtypes.Plane_EVM:
+		_, err = hex.DecodeString(addr)
+	default:
+		err = fmt.Errorf("unsupported plane: %s", plane)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s contract address %s: %w", plane, addr, err)
+	}
+	return nil
+}
+
+func decodeBytes(s string) ([]byte, error) {
+	switch {
+	case strings.HasPrefix(s, "hex:"):
+		return hex.DecodeString(strings.TrimPrefix(s, "hex:"))
+	case strings.HasPrefix(s, "base64:"):
+		return base64.StdEncoding.DecodeString(strings.TrimPrefix(s, "base64:"))
+	}
+	return []byte(s), nil
+}
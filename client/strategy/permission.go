@@ -0,0 +1,245 @@
+package strategy
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	strategytypes "github.com/FluxNFTLabs/sdk-go/chain/modules/strategy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// PermissionChange is the trigger permission update of a strategy.
+type PermissionChange struct {
+	Id          string
+	TypeChanged bool
+	Added       []string
+	Removed     []string
+	Msg         *strategytypes.MsgConfigStrategy
+}
+
+// DiffPermission compares the deployed trigger permission with the desired one, returning
+// nil when they match. Addresses are ignored for anyone permissions, a nil permission is
+// anyone.
+func DiffPermission(s *strategytypes.Strategy, desired *strategytypes.PermissionConfig) *PermissionChange {
+	current := normalizePermission(s.TriggerPermission)
+	wanted := normalizePermission(desired)
+
+	change := &PermissionChange{
+		Id:          hex.EncodeToString(s.Id),
+		TypeChanged: current.Type != wanted.Type,
+		Added:       missing(wanted.Addresses, current.Addresses),
+		Removed:     missing(current.Addresses, wanted.Addresses),
+	}
+	if !change.TypeChanged && len(change.Added) == 0 && len(change.Removed) == 0 {
+		return nil
+	}
+	return change
+}
+
+func normalizePermission(p *strategytypes.PermissionConfig) *strategytypes.PermissionConfig {
+	if p == nil || p.Type == strategytypes.AccessType_anyone {
+		return &strategytypes.PermissionConfig{Type: strategytypes.AccessType_anyone}
+	}
+
+	addrs := append([]string{}, p.Addresses...)
+	sort.Strings(addrs)
+	return &strategytypes.PermissionConfig{Type: p.Type, Addresses: addrs}
+}
+
+// missing returns the elements of a not in b.
+func missing(a, b []string) []string {
+	set := make(map[string]struct{}, len(b))
+	for _, s := range b {
+		set[s] = struct{}{}
+	}
+
+	var res []string
+	for _, s := range a {
+		if _, ok := set[s]; !ok {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// AppRef identifies an app contract on a plane.
+type AppRef struct {
+	Plane           astromeshtypes.Plane
+	ContractAddress string
+}
+
+// matches compares contract addresses like the chain, EVM addresses are hex with or
+// without 0x prefix.
+func (a AppRef) matches(app *strategytypes.SupportedApp) bool {
+	if app.Plane != a.Plane {
+		return false
+	}
+	if a.Plane == astromeshtypes.Plane_EVM {
+		return strings.EqualFold(strings.TrimPrefix(app.ContractAddress, "0x"), strings.TrimPrefix(a.ContractAddress, "0x"))
+	}
+	return app.ContractAddress == a.ContractAddress
+}
+
+// PendingVerification is a strategy claiming to support an app it's not verified for.
+type PendingVerification struct {
+	Strategy *strategytypes.Strategy
+	App      *strategytypes.SupportedApp
+}
+
+// PermissionManager manages the trigger permissions of the sender strategies, and the
+// verification of strategies supporting the sender apps.
+type PermissionManager struct {
+	client      Broadcaster
+	queryClient strategytypes.QueryClient
+}
+
+func NewPermissionManager(client Broadcaster, queryClient strategytypes.QueryClient) *PermissionManager {
+	return &PermissionManager{
+		client:      client,
+		queryClient: queryClient,
+	}
+}
+
+// PlanPermissions builds the updates bringing the trigger permissions of the strategies
+// to desired, keyed by strategy id. Strategies already matching are skipped.
+func (m *PermissionManager) PlanPermissions(ctx context.Context, desired map[string]*strategytypes.PermissionConfig) ([]*PermissionChange, error) {
+	ids := sortedKeys(desired)
+	changes := make([]*PermissionChange, 0, len(ids))
+	for _, id := range ids {
+		res, err := m.queryClient.GetStrategyById(ctx, &strategytypes.GetStrategyByIdRequest{Id: id})
+		if err != nil {
+			return nil, fmt.Errorf("get strategy %s err: %w", id, err)
+		}
+
+		s := res.Strategy
+		if s.Owner != m.client.FromAddress().String() {
+			return nil, fmt.Errorf("strategy %s is owned by %s", id, s.Owner)
+		}
+
+		change := DiffPermission(s, desired[id])
+		if change == nil {
+			continue
+		}
+
+		for _, addr := range desired[id].GetAddresses() {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return nil, fmt.Errorf("strategy %s: invalid address %q: %w", id, addr, err)
+			}
+		}
+
+		// query is sent unchanged, metadata is left untouched when nil
+		change.Msg = &strategytypes.MsgConfigStrategy{
+			Sender:            m.client.FromAddress().String(),
+			Config:            strategytypes.Config_update,
+			Id:                id,
+			Query:             s.Query,
+			TriggerPermission: normalizePermission(desired[id]),
+		}
+		if err := change.Msg.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("strategy %s: %w", id, err)
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// ApplyPermissions applies the planned permission updates in a single tx, the response
+// is nil when nothing changes.
+func (m *PermissionManager) ApplyPermissions(ctx context.Context, desired map[string]*strategytypes.PermissionConfig) ([]*PermissionChange, *txtypes.BroadcastTxResponse, error) {
+	changes, err := m.PlanPermissions(ctx, desired)
+	if err != nil || len(changes) == 0 {
+		return changes, nil, err
+	}
+
+	msgs := make([]sdk.Msg, 0, len(changes))
+	for _, c := range changes {
+		msgs = append(msgs, c.Msg)
+	}
+
+	res, err := broadcast(m.client, msgs)
+	return changes, res, err
+}
+
+// PendingVerifications lists the live strategies supporting one of the apps without
+// being verified for it.
+func (m *PermissionManager) PendingVerifications(ctx context.Context, apps ...AppRef) ([]*PendingVerification, error) {
+	res, err := m.queryClient.ListStrategies(ctx, &strategytypes.ListStrategiesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("list strategies err: %w", err)
+	}
+
+	var pending []*PendingVerification
+	for _, s := range res.Strategies {
+		if s.Deleted != 0 || s.Metadata == nil {
+			continue
+		}
+		for _, supported := range s.Metadata.SupportedApps {
+			if supported.Verified {
+				continue
+			}
+			for _, app := range apps {
+				if app.matches(supported) {
+					pending = append(pending, &PendingVerification{Strategy: s, App: supported})
+					break
+				}
+			}
+		}
+	}
+	return pending, nil
+}
+
+// Verifier returns the account allowed to verify strategies for app.
+func (m *PermissionManager) Verifier(ctx context.Context, app AppRef) (string, error) {
+	res, err := m.queryClient.GetStrategyVerifier(ctx, &strategytypes.GetStrategyVerifierRequest{
+		Plane:           app.Plane,
+		ContractAddress: app.ContractAddress,
+	})
+	if err != nil {
+		return "", fmt.Errorf("get verifier of %s %s err: %w", app.Plane, app.ContractAddress, err)
+	}
+	return res.Verifier, nil
+}
+
+// Verify verifies the strategies in a single tx, the sender must be the verifier of
+// every app.
+func (m *PermissionManager) Verify(ctx context.Context, pending ...*PendingVerification) (*txtypes.BroadcastTxResponse, error) {
+	sender := m.client.FromAddress().String()
+	checked := map[AppRef]struct{}{}
+	msgs := make([]sdk.Msg, 0, len(pending))
+	for _, p := range pending {
+		app := AppRef{Plane: p.App.Plane, ContractAddress: p.App.ContractAddress}
+		if _, ok := checked[app]; !ok {
+			verifier, err := m.Verifier(ctx, app)
+			if err != nil {
+				return nil, err
+			}
+			if verifier != sender {
+				return nil, fmt.Errorf("%s is not the verifier of %s %s, %s is", sender, app.Plane, app.ContractAddress, verifier)
+			}
+			checked[app] = struct{}{}
+		}
+
+		msgs = append(msgs, &strategytypes.MsgVerifyStrategy{
+			Sender:          sender,
+			ContractAddress: p.App.ContractAddress,
+			Plane:           p.App.Plane,
+			StrategyId:      hex.EncodeToString(p.Strategy.Id),
+		})
+	}
+	return broadcast(m.client, msgs)
+}
+
+// SetVerifier hands the verification of app over to newVerifier.
+func (m *PermissionManager) SetVerifier(app AppRef, newVerifier sdk.AccAddress) (*txtypes.BroadcastTxResponse, error) {
+	return broadcast(m.client, []sdk.Msg{&strategytypes.MsgSetVerifier{
+		Sender:      m.client.FromAddress().String(),
+		Contract:    app.ContractAddress,
+		Plane:       app.Plane,
+		NewVerifier: newVerifier.String(),
+	}})
+}
@@ -0,0 +1,196 @@
+package strategy
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"text/template"
+
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	strategytypes "github.com/FluxNFTLabs/sdk-go/chain/modules/strategy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/mr-tron/base58"
+)
+
+// QueryResolver resolves the templates of strategy queries. Query addresses and inputs
+// reference ${wallet} and the ${name} input values, and may be text/templates computing
+// SVM addresses, e.g. the drift user account of the sender:
+//
+//	{{pda "user" (decodeBase58 svmAddress) "\x00\x00" "FLR3mfYrMZUnhqEadNJVwjUhjX8ky9vE9qTtDmkK4vwC"}}
+//
+// Template functions work on raw bytes strings: pda(seeds..., programId) returns the
+// address bytes, decodeBase58 and decodeHex decode, encodeBase58 and encodeHex encode,
+// bech32ToHex returns the hex of a cosmos address. wallet and svmAddress return the
+// sender bech32 and base58 SVM addresses, input values are fields of the template data.
+type QueryResolver struct {
+	Wallet string
+	Values map[string]string
+	// SvmAddress returns the SVM account linked to the wallet, nil if unknown
+	SvmAddress func() (solana.PublicKey, error)
+}
+
+// Resolve resolves the instructions of a deployed strategy query.
+func (r *QueryResolver) Resolve(q *astromeshtypes.FISQueryRequest) (*astromeshtypes.FISQueryRequest, error) {
+	query := &astromeshtypes.FISQueryRequest{
+		Instructions: []*astromeshtypes.FISQueryInstruction{},
+	}
+	if q == nil {
+		return query, nil
+	}
+
+	for i, ix := range q.Instructions {
+		resolved, err := r.resolveInstruction(ix.Plane, ix.Action, ix.Address, ix.Input)
+		if err != nil {
+			return nil, fmt.Errorf("query %d: %w", i, err)
+		}
+		query.Instructions = append(query.Instructions, resolved)
+	}
+	return query, nil
+}
+
+// ResolveSchema resolves the instructions of an intent schema prompt query.
+func (r *QueryResolver) ResolveSchema(q *strategytypes.SchemaFISQuery) (*astromeshtypes.FISQueryRequest, error) {
+	query := &astromeshtypes.FISQueryRequest{
+		Instructions: []*astromeshtypes.FISQueryInstruction{},
+	}
+	if q == nil {
+		return query, nil
+	}
+
+	for i, ix := range q.Instructions {
+		plane, err := parsePlane(ix.Plane)
+		if err != nil {
+			return nil, fmt.Errorf("query %d: %w", i, err)
+		}
+		action, ok := astromeshtypes.QueryAction_value[ix.Action]
+		if !ok {
+			return nil, fmt.Errorf("query %d: unknown action %q", i, ix.Action)
+		}
+
+		resolved, err := r.resolveInstruction(plane, astromeshtypes.QueryAction(action), ix.Address, ix.Input)
+		if err != nil {
+			return nil, fmt.Errorf("query %d: %w", i, err)
+		}
+		query.Instructions = append(query.Instructions, resolved)
+	}
+	return query, nil
+}
+
+func (r *QueryResolver) resolveInstruction(plane astromeshtypes.Plane, action astromeshtypes.QueryAction, address []byte, input [][]byte) (*astromeshtypes.FISQueryInstruction, error) {
+	ix := &astromeshtypes.FISQueryInstruction{
+		Plane:  plane,
+		Action: action,
+	}
+
+	var err error
+	ix.Address, err = r.ResolveBytes(address)
+	if err != nil {
+		return nil, fmt.Errorf("address: %w", err)
+	}
+
+	for i, in := range input {
+		resolved, err := r.ResolveBytes(in)
+		if err != nil {
+			return nil, fmt.Errorf("input %d: %w", i, err)
+		}
+		ix.Input = append(ix.Input, resolved)
+	}
+	return ix, nil
+}
+
+// ResolveBytes resolves a single query address or input.
+func (r *QueryResolver) ResolveBytes(bz []byte) ([]byte, error) {
+	var unresolved []string
+	bz = promptVarReg.ReplaceAllFunc(bz, func(s []byte) []byte {
+		name, _, _ := bytes.Cut(s[2:len(s)-1], []byte(":"))
+		if string(name) == WalletVar && r.Wallet != "" {
+			return []byte(r.Wallet)
+		}
+		if v, ok := r.Values[string(name)]; ok {
+			return []byte(v)
+		}
+		unresolved = append(unresolved, string(s))
+		return s
+	})
+	if len(unresolved) > 0 {
+		return nil, fmt.Errorf("unresolved %s", strings.Join(unresolved, ", "))
+	}
+
+	if !bytes.Contains(bz, []byte("{{")) {
+		return bz, nil
+	}
+
+	tmpl, err := template.New("query").Option("missingkey=error").Funcs(r.funcs()).Parse(string(bz))
+	if err != nil {
+		return nil, fmt.Errorf("parse query template err: %w", err)
+	}
+
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, r.Values); err != nil {
+		return nil, fmt.Errorf("execute query template err: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+func (r *QueryResolver) funcs() template.FuncMap {
+	return template.FuncMap{
+		"wallet": func() (string, error) {
+			if r.Wallet == "" {
+				return "", fmt.Errorf("wallet is unknown")
+			}
+			return r.Wallet, nil
+		},
+		"svmAddress": func() (string, error) {
+			if r.SvmAddress == nil {
+				return "", fmt.Errorf("svm address is unknown")
+			}
+			pubkey, err := r.SvmAddress()
+			if err != nil {
+				return "", err
+			}
+			return pubkey.String(), nil
+		},
+		"pda": func(args ...string) (string, error) {
+			if len(args) < 2 {
+				return "", fmt.Errorf("pda expects seeds and a program id")
+			}
+			programId, err := solana.PublicKeyFromBase58(args[len(args)-1])
+			if err != nil {
+				return "", fmt.Errorf("invalid pda program id: %w", err)
+			}
+
+			seeds := make([][]byte, 0, len(args)-1)
+			for _, seed := range args[:len(args)-1] {
+				seeds = append(seeds, []byte(seed))
+			}
+			pda, _, err := solana.FindProgramAddress(seeds, programId)
+			if err != nil {
+				return "", err
+			}
+			return string(pda[:]), nil
+		},
+		"decodeBase58": func(s string) (string, error) {
+			bz, err := base58.Decode(s)
+			return string(bz), err
+		},
+		"encodeBase58": func(s string) string {
+			return base58.Encode([]byte(s))
+		},
+		"decodeHex": func(s string) (string, error) {
+			bz, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+			return string(bz), err
+		},
+		"encodeHex": func(s string) string {
+			return hex.EncodeToString([]byte(s))
+		},
+		"bech32ToHex": func(s string) (string, error) {
+			addr, err := sdk.AccAddressFromBech32(s)
+			if err != nil {
+				return "", err
+			}
+			return hex.EncodeToString(addr), nil
+		},
+	}
+}
@@ -0,0 +1,346 @@
+package strategy
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	strategytypes "github.com/FluxNFTLabs/sdk-go/chain/modules/strategy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/goccy/go-json"
+)
+
+// VarType is the type of a ${name:type} prompt variable. The chain does not check
+// types, values of unknown types are only required to be non-empty.
+type VarType string
+
+const (
+	VarString VarType = "string"
+	// VarNumber is a decimal number, e.g. 1.5
+	VarNumber VarType = "number"
+	// VarAmount is a positive integer amount in the smallest unit of a denom
+	VarAmount VarType = "amount"
+	// VarPercent is a decimal between 0 and 100
+	VarPercent VarType = "percent"
+	// VarAddress is a cosmos bech32, EVM hex or SVM base58 address
+	VarAddress VarType = "address"
+	VarDenom   VarType = "denom"
+)
+
+// WalletVar is the query variable replaced by the trigger sender.
+const WalletVar = "wallet"
+
+var promptVarReg = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+type PromptVar struct {
+	Name string
+	Type VarType
+}
+
+// Prompt is a parsed intent solver prompt.
+type Prompt struct {
+	Group string
+	// Name is the prompt key, also the action key of the trigger input
+	Name     string
+	Template string
+	Vars     []PromptVar
+	// MsgFields are the vars sent in the trigger input, all vars when the schema
+	// doesn't declare them
+	MsgFields  []string
+	Query      *strategytypes.SchemaFISQuery
+	Extensions map[string]string
+}
+
+// IntentSchema is a parsed StrategyMetadata.Schema.
+type IntentSchema struct {
+	Prompts []*Prompt
+}
+
+// schemaMsgFields decodes the msg_fields of prompts, which are not part of the proto.
+type schemaMsgFields struct {
+	Groups []struct {
+		Prompts map[string]struct {
+			MsgFields []string `json:"msg_fields"`
+		} `json:"prompts"`
+	} `json:"groups"`
+}
+
+// ParseSchema parses and validates an intent schema. Prompt names must be unique across
+// groups as the trigger input only carries the prompt name.
+func ParseSchema(schema string) (*IntentSchema, error) {
+	var s strategytypes.Schema
+	if err := json.Unmarshal([]byte(schema), &s); err != nil {
+		return nil, fmt.Errorf("unmarshal schema err: %w", err)
+	}
+
+	var fields schemaMsgFields
+	if err := json.Unmarshal([]byte(schema), &fields); err != nil {
+		return nil, fmt.Errorf("unmarshal schema err: %w", err)
+	}
+
+	res := &IntentSchema{}
+	seen := map[string]string{}
+	for i, g := range s.Groups {
+		if len(s.Groups) > 1 && g.Name == "" {
+			return nil, fmt.Errorf("group name should not be empty when there are many groups")
+		}
+
+		for _, name := range sortedKeys(g.Prompts) {
+			if group, ok := seen[name]; ok {
+				return nil, fmt.Errorf("prompt %s is defined in groups %q and %q", name, group, g.Name)
+			}
+			seen[name] = g.Name
+
+			p, err := parsePrompt(name, g.Prompts[name])
+			if err != nil {
+				return nil, err
+			}
+			p.Group = g.Name
+			if err := p.setMsgFields(fields.Groups[i].Prompts[name].MsgFields); err != nil {
+				return nil, err
+			}
+			res.Prompts = append(res.Prompts, p)
+		}
+	}
+	return res, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func parsePrompt(name string, sp *strategytypes.SchemaPrompt) (*Prompt, error) {
+	if sp == nil {
+		return nil, fmt.Errorf("prompt %s is empty", name)
+	}
+	if err := sp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("prompt %s: %w", name, err)
+	}
+
+	p := &Prompt{
+		Name:       name,
+		Template:   sp.Template,
+		Query:      sp.Query,
+		Extensions: sp.Extensions,
+	}
+
+	types := map[string]VarType{}
+	for _, m := range promptVarReg.FindAllStringSubmatch(sp.Template, -1) {
+		varName, varType, _ := strings.Cut(m[1], ":")
+		if t, ok := types[varName]; ok {
+			if t != VarType(varType) {
+				return nil, fmt.Errorf("prompt %s: var %s declared as %s and %s", name, varName, t, varType)
+			}
+			continue
+		}
+		types[varName] = VarType(varType)
+		p.Vars = append(p.Vars, PromptVar{Name: varName, Type: VarType(varType)})
+	}
+	return p, nil
+}
+
+func (p *Prompt) setMsgFields(fields []string) error {
+	if len(fields) == 0 {
+		for _, v := range p.Vars {
+			p.MsgFields = append(p.MsgFields, v.Name)
+		}
+		return nil
+	}
+
+	for _, f := range fields {
+		if _, ok := p.Var(f); !ok {
+			return fmt.Errorf("prompt %s: msg field %s is not a template var", p.Name, f)
+		}
+	}
+	p.MsgFields = fields
+	return nil
+}
+
+// Prompt returns the prompt with name.
+func (s *IntentSchema) Prompt(name string) (*Prompt, error) {
+	for _, p := range s.Prompts {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown prompt %s", name)
+}
+
+// Match finds the prompt whose template matches text, returning the values of its vars.
+func (s *IntentSchema) Match(text string) (*Prompt, map[string]string, bool) {
+	for _, p := range s.Prompts {
+		if values, ok := p.Match(text); ok {
+			return p, values, true
+		}
+	}
+	return nil, nil, false
+}
+
+func (p *Prompt) Var(name string) (PromptVar, bool) {
+	for _, v := range p.Vars {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return PromptVar{}, false
+}
+
+// Validate checks values holds a valid value for every var, and nothing else.
+func (p *Prompt) Validate(values map[string]string) error {
+	for _, v := range p.Vars {
+		value, ok := values[v.Name]
+		if !ok {
+			return fmt.Errorf("missing value for %s", v.Name)
+		}
+		if err := v.Validate(value); err != nil {
+			return err
+		}
+	}
+
+	for name := range values {
+		if _, ok := p.Var(name); !ok {
+			return fmt.Errorf("prompt %s has no var %s", p.Name, name)
+		}
+	}
+	return nil
+}
+
+// Validate checks value is a valid value of the var type.
+func (v PromptVar) Validate(value string) error {
+	if value == "" {
+		return fmt.Errorf("%s must not be empty", v.Name)
+	}
+
+	var err error
+	switch v.Type {
+	case VarNumber:
+		_, err = sdkmath.LegacyNewDecFromStr(value)
+	case VarAmount:
+		amount, ok := sdkmath.NewIntFromString(value)
+		if !ok || !amount.IsPositive() {
+			err = fmt.Errorf("not a positive integer")
+		}
+	case VarPercent:
+		var percent sdkmath.LegacyDec
+		percent, err = sdkmath.LegacyNewDecFromStr(value)
+		if err == nil && (percent.IsNegative() || percent.GT(sdkmath.LegacyNewDec(100))) {
+			err = fmt.Errorf("not between 0 and 100")
+		}
+	case VarAddress:
+		err = validateAddress(value)
+	case VarDenom:
+		err = sdk.ValidateDenom(value)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s %s %q: %w", v.Type, v.Name, value, err)
+	}
+	return nil
+}
+
+func validateAddress(value string) error {
+	if common.IsHexAddress(value) {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(value); err == nil {
+		return nil
+	}
+	if _, err := solana.PublicKeyFromBase58(value); err == nil {
+		return nil
+	}
+	return fmt.Errorf("not a cosmos, EVM or SVM address")
+}
+
+// Render fills the template with values.
+func (p *Prompt) Render(values map[string]string) (string, error) {
+	if err := p.Validate(values); err != nil {
+		return "", err
+	}
+
+	return promptVarReg.ReplaceAllStringFunc(p.Template, func(s string) string {
+		name, _, _ := strings.Cut(s[2:len(s)-1], ":")
+		return values[name]
+	}), nil
+}
+
+// Match parses text filled from the template, returning the var values.
+func (p *Prompt) Match(text string) (map[string]string, bool) {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	last := 0
+	for _, loc := range promptVarReg.FindAllStringIndex(p.Template, -1) {
+		pattern.WriteString(regexp.QuoteMeta(p.Template[last:loc[0]]))
+		pattern.WriteString("(.+?)")
+		last = loc[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(p.Template[last:]))
+	pattern.WriteString("$")
+
+	m := regexp.MustCompile(pattern.String()).FindStringSubmatch(strings.TrimSpace(text))
+	if m == nil {
+		return nil, false
+	}
+
+	values := map[string]string{}
+	for i, s := range promptVarReg.FindAllStringSubmatch(p.Template, -1) {
+		name, _, _ := strings.Cut(s[1], ":")
+		if prev, ok := values[name]; ok && prev != m[i+1] {
+			return nil, false
+		}
+		values[name] = m[i+1]
+	}
+	return values, p.Validate(values) == nil
+}
+
+// Input builds the trigger input of the prompt, {"<prompt>":{"<msg field>":"<value>"}}.
+func (p *Prompt) Input(values map[string]string) ([]byte, error) {
+	if err := p.Validate(values); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]string, len(p.MsgFields))
+	for _, f := range p.MsgFields {
+		fields[f] = values[f]
+	}
+	return json.Marshal(map[string]map[string]string{p.Name: fields})
+}
+
+// FISQuery builds the FIS query of the prompt, replacing ${wallet} with the wallet
+// address and ${var} references with the values, see QueryResolver for svm templates.
+func (p *Prompt) FISQuery(wallet string, values map[string]string) (*astromeshtypes.FISQueryRequest, error) {
+	resolver := &QueryResolver{Wallet: wallet, Values: values}
+	return resolver.ResolveSchema(p.Query)
+}
+
+// Trigger builds the message triggering the intent solver with the prompt filled by values.
+func (p *Prompt) Trigger(sender sdk.AccAddress, strategyId string, values map[string]string) (*strategytypes.MsgTriggerStrategies, error) {
+	input, err := p.Input(values)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := p.FISQuery(sender.String(), values)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &strategytypes.MsgTriggerStrategies{
+		Sender:  sender.String(),
+		Ids:     []string{strategyId},
+		Inputs:  [][]byte{input},
+		Queries: []*astromeshtypes.FISQueryRequest{query},
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
@@ -0,0 +1,182 @@
+package strategy
+
+import (
+	"os"
+	"testing"
+
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	"github.com/stretchr/testify/require"
+)
+
+func loadAmmSchema(t *testing.T) *IntentSchema {
+	bz, err := os.ReadFile("../../examples/chain/36_ConfigAmmSolver/schema.json")
+	require.NoError(t, err)
+
+	schema, err := ParseSchema(string(bz))
+	require.NoError(t, err)
+	return schema
+}
+
+func TestParseSchema(t *testing.T) {
+	schema := loadAmmSchema(t)
+	require.Len(t, schema.Prompts, 2)
+
+	arbitrage, err := schema.Prompt("arbitrage")
+	require.NoError(t, err)
+	require.Equal(t, "AMM Solver", arbitrage.Group)
+	require.Equal(t, []PromptVar{
+		{Name: "amount", Type: VarNumber},
+		{Name: "pair", Type: VarString},
+		{Name: "min_profit", Type: VarNumber},
+	}, arbitrage.Vars)
+	require.Equal(t, []string{"amount", "pair", "min_profit"}, arbitrage.MsgFields)
+	require.Len(t, arbitrage.Query.Instructions, 7)
+
+	swap, err := schema.Prompt("swap")
+	require.NoError(t, err)
+	require.Equal(t, []PromptVar{
+		{Name: "amount", Type: VarNumber},
+		{Name: "src_denom", Type: VarString},
+		{Name: "dst_denom", Type: VarString},
+		{Name: "dex_name", Type: VarString},
+	}, swap.Vars)
+
+	_, err = schema.Prompt("unknown")
+	require.Error(t, err)
+}
+
+func TestParseSchemaErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		schema string
+		err    string
+	}{
+		{
+			"duplicated prompt",
+			`{"groups":[{"name":"a","prompts":{"p":{"template":"p ${x:number}"}}},{"name":"b","prompts":{"p":{"template":"q ${x:number}"}}}]}`,
+			"prompt p is defined in groups",
+		},
+		{
+			"unnamed group",
+			`{"groups":[{"name":"a","prompts":{"p":{"template":"p ${x:number}"}}},{"prompts":{"q":{"template":"q ${x:number}"}}}]}`,
+			"group name should not be empty",
+		},
+		{
+			"conflicting var types",
+			`{"groups":[{"prompts":{"p":{"template":"p ${x:number} ${x:string}"}}}]}`,
+			"var x declared as number and string",
+		},
+		{
+			"unknown msg field",
+			`{"groups":[{"prompts":{"p":{"template":"p ${x:number}","msg_fields":["y"]}}}]}`,
+			"msg field y is not a template var",
+		},
+		{
+			"invalid json",
+			`{"groups":`,
+			"unmarshal schema err",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseSchema(tc.schema)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestSchemaMatch(t *testing.T) {
+	schema := loadAmmSchema(t)
+
+	p, values, ok := schema.Match("swap 1.5 usdt to sol on raydium")
+	require.True(t, ok)
+	require.Equal(t, "swap", p.Name)
+	require.Equal(t, map[string]string{
+		"amount":    "1.5",
+		"src_denom": "usdt",
+		"dst_denom": "sol",
+		"dex_name":  "raydium",
+	}, values)
+
+	p, values, ok = schema.Match("  arbitrage 100 USDT on pair btc-usdt with minimum profit = 0.5 USDT ")
+	require.True(t, ok)
+	require.Equal(t, "arbitrage", p.Name)
+	require.Equal(t, map[string]string{"amount": "100", "pair": "btc-usdt", "min_profit": "0.5"}, values)
+
+	// values must be valid for their type
+	_, _, ok = schema.Match("swap all usdt to sol on raydium")
+	require.False(t, ok)
+	_, _, ok = schema.Match("bridge 1 usdt to evm")
+	require.False(t, ok)
+}
+
+func TestPromptValidate(t *testing.T) {
+	swap, err := loadAmmSchema(t).Prompt("swap")
+	require.NoError(t, err)
+
+	values := map[string]string{"amount": "1.5", "src_denom": "usdt", "dst_denom": "sol", "dex_name": "raydium"}
+	require.NoError(t, swap.Validate(values))
+
+	rendered, err := swap.Render(values)
+	require.NoError(t, err)
+	require.Equal(t, "swap 1.5 usdt to sol on raydium", rendered)
+
+	input, err := swap.Input(values)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"swap":{"amount":"1.5","src_denom":"usdt","dst_denom":"sol","dex_name":"raydium"}}`, string(input))
+
+	require.ErrorContains(t, swap.Validate(map[string]string{"amount": "1.5"}), "missing value for src_denom")
+
+	values["amount"] = "one"
+	require.ErrorContains(t, swap.Validate(values), "invalid number amount")
+
+	values["amount"] = "1"
+	values["slippage"] = "1"
+	require.ErrorContains(t, swap.Validate(values), "prompt swap has no var slippage")
+}
+
+func TestPromptVarValidate(t *testing.T) {
+	testCases := []struct {
+		v     PromptVar
+		value string
+		valid bool
+	}{
+		{PromptVar{"x", VarString}, "anything", true},
+		{PromptVar{"x", VarString}, "", false},
+		{PromptVar{"x", VarAmount}, "100", true},
+		{PromptVar{"x", VarAmount}, "0", false},
+		{PromptVar{"x", VarAmount}, "1.5", false},
+		{PromptVar{"x", VarPercent}, "99.5", true},
+		{PromptVar{"x", VarPercent}, "101", false},
+		{PromptVar{"x", VarPercent}, "-1", false},
+		{PromptVar{"x", VarAddress}, "lux158ucxjzr6ccrlpmz8z05wylu8tr5eueqcp2afu", true},
+		{PromptVar{"x", VarAddress}, "0xab6b4d064c968eca87f775d2493a222987052bc0", true},
+		{PromptVar{"x", VarAddress}, "11111111111111111111111111111111", true},
+		{PromptVar{"x", VarAddress}, "not an address", false},
+		{PromptVar{"x", VarDenom}, "lux", true},
+		{PromptVar{"x", VarDenom}, "1lux", false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.v.Validate(tc.value)
+		if tc.valid {
+			require.NoError(t, err, "%s %q", tc.v.Type, tc.value)
+		} else {
+			require.Error(t, err, "%s %q", tc.v.Type, tc.value)
+		}
+	}
+}
+
+func TestPromptFISQuery(t *testing.T) {
+	swap, err := loadAmmSchema(t).Prompt("swap")
+	require.NoError(t, err)
+
+	wallet := "lux158ucxjzr6ccrlpmz8z05wylu8tr5eueqcp2afu"
+	query, err := swap.FISQuery(wallet, map[string]string{"amount": "1.5", "src_denom": "usdt", "dst_denom": "sol", "dex_name": "raydium"})
+	require.NoError(t, err)
+	require.Len(t, query.Instructions, 1)
+	require.Equal(t, astromeshtypes.Plane_COSMOS, query.Instructions[0].Plane)
+	require.Equal(t, astromeshtypes.QueryAction_COSMOS_QUERY, query.Instructions[0].Action)
+	require.Equal(t, [][]byte{[]byte("/flux/svm/v1beta1/account_link/cosmos/" + wallet)}, query.Instructions[0].Input)
+}
@@ -0,0 +1,233 @@
+package strategy
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	strategytypes "github.com/FluxNFTLabs/sdk-go/chain/modules/strategy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/goccy/go-json"
+)
+
+// TriggerClient is the part of chain.ChainClient used by TriggerBuilder.
+type TriggerClient interface {
+	FromAddress() sdk.AccAddress
+	GetSVMAccountLink(ctx context.Context, cosmosAddress sdk.AccAddress) (isLinked bool, pubkey solana.PublicKey, err error)
+}
+
+// TriggerBuilder builds MsgTriggerStrategies with the queries the strategies expect,
+// resolved from their metadata instead of being assembled by hand.
+type TriggerBuilder struct {
+	client          TriggerClient
+	queryClient     strategytypes.QueryClient
+	astromeshClient astromeshtypes.QueryClient
+}
+
+// NewTriggerBuilder creates a trigger builder, astromeshClient is only used by Check
+// and may be nil otherwise.
+func NewTriggerBuilder(client TriggerClient, queryClient strategytypes.QueryClient, astromeshClient astromeshtypes.QueryClient) *TriggerBuilder {
+	return &TriggerBuilder{
+		client:          client,
+		queryClient:     queryClient,
+		astromeshClient: astromeshClient,
+	}
+}
+
+// Build fetches the strategy and builds its trigger for input. Intent solvers use the
+// query of the schema prompt named by the input action, other strategies their
+// deployed query.
+func (b *TriggerBuilder) Build(ctx context.Context, id string, input []byte) (*strategytypes.MsgTriggerStrategies, error) {
+	res, err := b.queryClient.GetStrategyById(ctx, &strategytypes.GetStrategyByIdRequest{Id: id})
+	if err != nil {
+		return nil, fmt.Errorf("get strategy %s err: %w", id, err)
+	}
+	return b.BuildFor(ctx, res.Strategy, input)
+}
+
+// BuildFor builds the trigger of a fetched strategy for input.
+func (b *TriggerBuilder) BuildFor(ctx context.Context, s *strategytypes.Strategy, input []byte) (*strategytypes.MsgTriggerStrategies, error) {
+	id := hex.EncodeToString(s.Id)
+	if s.Deleted != 0 {
+		return nil, fmt.Errorf("strategy %s is deleted", id)
+	}
+	if !s.IsEnabled {
+		return nil, fmt.Errorf("strategy %s is disabled", id)
+	}
+
+	sender := b.client.FromAddress()
+	if err := checkTriggerPermission(s, sender); err != nil {
+		return nil, err
+	}
+
+	resolver := &QueryResolver{
+		Wallet:     sender.String(),
+		SvmAddress: b.svmAddress(ctx, sender),
+	}
+
+	var query *astromeshtypes.FISQueryRequest
+	if s.Metadata != nil && s.Metadata.Type == strategytypes.StrategyType_INTENT_SOLVER && s.Metadata.Schema != "" {
+		schema, err := ParseSchema(s.Metadata.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("strategy %s schema: %w", id, err)
+		}
+
+		action, values, err := intentValues(input)
+		if err != nil {
+			return nil, err
+		}
+		prompt, err := schema.Prompt(action)
+		if err != nil {
+			return nil, fmt.Errorf("strategy %s: %w", id, err)
+		}
+		if err := checkIntentValues(prompt, values); err != nil {
+			return nil, fmt.Errorf("strategy %s %s: %w", id, action, err)
+		}
+
+		resolver.Values = values
+		query, err = resolver.ResolveSchema(prompt.Query)
+		if err != nil {
+			return nil, fmt.Errorf("strategy %s %s: %w", id, action, err)
+		}
+	} else {
+		var err error
+		resolver.Values = inputValues(input)
+		query, err = resolver.Resolve(s.Query)
+		if err != nil {
+			return nil, fmt.Errorf("strategy %s: %w", id, err)
+		}
+	}
+
+	msg := &strategytypes.MsgTriggerStrategies{
+		Sender:  sender.String(),
+		Ids:     []string{id},
+		Inputs:  [][]byte{input},
+		Queries: []*astromeshtypes.FISQueryRequest{query},
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// Check runs the queries of a trigger against the node, failing like the trigger would
+// on queries the chain can't answer.
+func (b *TriggerBuilder) Check(ctx context.Context, msg *strategytypes.MsgTriggerStrategies) error {
+	if len(msg.Ids) != len(msg.Inputs) || (len(msg.Queries) > 0 && len(msg.Queries) != len(msg.Ids)) {
+		return fmt.Errorf("trigger has %d ids, %d inputs and %d queries", len(msg.Ids), len(msg.Inputs), len(msg.Queries))
+	}
+	if b.astromeshClient == nil {
+		return fmt.Errorf("trigger builder has no astromesh query client")
+	}
+
+	for i, q := range msg.Queries {
+		for j, ix := range q.Instructions {
+			for _, bz := range append([][]byte{ix.Address}, ix.Input...) {
+				if bytes.Contains(bz, []byte("${")) || bytes.Contains(bz, []byte("{{")) {
+					return fmt.Errorf("strategy %s query %d has unresolved template %q", msg.Ids[i], j, bz)
+				}
+			}
+		}
+
+		res, err := b.astromeshClient.FISQuery(ctx, q)
+		if err != nil {
+			return fmt.Errorf("strategy %s query err: %w", msg.Ids[i], err)
+		}
+		if len(res.InstructionResponses) != len(q.Instructions) {
+			return fmt.Errorf("strategy %s query returned %d responses for %d instructions", msg.Ids[i], len(res.InstructionResponses), len(q.Instructions))
+		}
+	}
+	return nil
+}
+
+// svmAddress resolves the linked SVM account on first use.
+func (b *TriggerBuilder) svmAddress(ctx context.Context, sender sdk.AccAddress) func() (solana.PublicKey, error) {
+	var (
+		pubkey   solana.PublicKey
+		resolved bool
+	)
+	return func() (solana.PublicKey, error) {
+		if resolved {
+			return pubkey, nil
+		}
+
+		isLinked, linked, err := b.client.GetSVMAccountLink(ctx, sender)
+		if err != nil {
+			return pubkey, fmt.Errorf("get svm account link of %s err: %w", sender, err)
+		}
+		if !isLinked {
+			return pubkey, fmt.Errorf("sender %s has no linked svm account", sender)
+		}
+		pubkey, resolved = linked, true
+		return pubkey, nil
+	}
+}
+
+func checkTriggerPermission(s *strategytypes.Strategy, sender sdk.AccAddress) error {
+	p := s.TriggerPermission
+	if p == nil || p.Type == strategytypes.AccessType_anyone {
+		return nil
+	}
+	for _, addr := range p.Addresses {
+		if addr == sender.String() {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not allowed to trigger strategy %s", sender, hex.EncodeToString(s.Id))
+}
+
+// intentValues decodes an intent input {"<action>":{"<field>":<value>}}.
+func intentValues(input []byte) (string, map[string]string, error) {
+	var intent map[string]map[string]json.RawMessage
+	if err := json.Unmarshal(input, &intent); err != nil || len(intent) != 1 {
+		return "", nil, fmt.Errorf("intent input must be a single action object: %s", input)
+	}
+
+	for action, fields := range intent {
+		return action, stringValues(fields), nil
+	}
+	return "", nil, nil
+}
+
+// inputValues returns the top level fields of a json object input, nil for other inputs.
+func inputValues(input []byte) map[string]string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(input, &fields); err != nil {
+		return nil
+	}
+	return stringValues(fields)
+}
+
+// stringValues keeps json strings as is, other values json encoded.
+func stringValues(fields map[string]json.RawMessage) map[string]string {
+	values := make(map[string]string, len(fields))
+	for k, raw := range fields {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			values[k] = s
+			continue
+		}
+		values[k] = strings.TrimSpace(string(raw))
+	}
+	return values
+}
+
+// checkIntentValues validates the msg fields of the input, the other template vars are
+// not sent to the solver.
+func checkIntentValues(p *Prompt, values map[string]string) error {
+	for _, f := range p.MsgFields {
+		v, _ := p.Var(f)
+		value, ok := values[f]
+		if !ok {
+			return fmt.Errorf("missing value for %s", f)
+		}
+		if err := v.Validate(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+// Package accounts fetches svm accounts and decodes them into the structs of the
+// program packages, looked up by owner program and account discriminator:
+//
+//	registry := accounts.NewRegistry()
+//	drift.RegisterAccounts(registry, driftProgramId)
+//	fetcher := accounts.NewFetcher(svmtypes.NewQueryClient(cc), registry)
+//	user, err := accounts.Get[drift.User](ctx, fetcher, userPubkey)
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+)
+
+var ErrUnknownType = errors.New("unknown account type")
+
+// AccountType is a registered account type of a program.
+type AccountType struct {
+	Name string
+	// Discriminator is the data prefix identifying the type, the 8 bytes anchor
+	// discriminator for anchor programs
+	Discriminator []byte
+	// New returns a pointer to a zero account to decode into
+	New func() bin.BinaryUnmarshaler
+}
+
+// Registry maps programs to their account types.
+type Registry struct {
+	programs map[solana.PublicKey][]*AccountType
+}
+
+func NewRegistry() *Registry {
+	return &Registry{programs: map[solana.PublicKey][]*AccountType{}}
+}
+
+// Register adds an account type of program, replacing any type with the same
+// discriminator.
+func (r *Registry) Register(program solana.PublicKey, name string, discriminator []byte, newAccount func() bin.BinaryUnmarshaler) {
+	types := r.programs[program]
+	for i, t := range types {
+		if bytes.Equal(t.Discriminator, discriminator) {
+			types = append(types[:i], types[i+1:]...)
+			break
+		}
+	}
+
+	types = append(types, &AccountType{Name: name, Discriminator: discriminator, New: newAccount})
+	// longest discriminators first so that prefixes don't shadow them
+	sort.SliceStable(types, func(i, j int) bool {
+		return len(types[i].Discriminator) > len(types[j].Discriminator)
+	})
+	r.programs[program] = types
+}
+
+// Type returns the registered type of account data owned by program.
+func (r *Registry) Type(program solana.PublicKey, data []byte) (*AccountType, error) {
+	types, ok := r.programs[program]
+	if !ok {
+		return nil, fmt.Errorf("%w: program %s has no registered account types", ErrUnknownType, program)
+	}
+
+	for _, t := range types {
+		if bytes.HasPrefix(data, t.Discriminator) {
+			return t, nil
+		}
+	}
+
+	prefix := data[:min(len(data), 8)]
+	return nil, fmt.Errorf("%w: program %s has no account type with discriminator %v", ErrUnknownType, program, prefix)
+}
+
+// Decode decodes account data owned by program.
+func (r *Registry) Decode(program solana.PublicKey, data []byte) (string, any, error) {
+	t, err := r.Type(program, data)
+	if err != nil {
+		return "", nil, err
+	}
+
+	account := t.New()
+	if err := bin.NewBorshDecoder(data).Decode(account); err != nil {
+		return "", nil, fmt.Errorf("decode %s err: %w", t.Name, err)
+	}
+	return t.Name, account, nil
+}
+
+// TypedAccount is a fetched account with its decoded data.
+type TypedAccount struct {
+	Pubkey     solana.PublicKey
+	Owner      solana.PublicKey
+	Lamports   uint64
+	Executable bool
+	// Type is the registered name of the account type, Data the decoded account, a
+	// pointer to the type struct
+	Type string
+	Data any
+}
+
+// Fetcher fetches and decodes svm accounts.
+type Fetcher struct {
+	queryClient svmtypes.QueryClient
+	registry    *Registry
+}
+
+func NewFetcher(queryClient svmtypes.QueryClient, registry *Registry) *Fetcher {
+	return &Fetcher{
+		queryClient: queryClient,
+		registry:    registry,
+	}
+}
+
+func (f *Fetcher) Registry() *Registry {
+	return f.registry
+}
+
+// GetTypedAccount fetches and decodes an account.
+func (f *Fetcher) GetTypedAccount(ctx context.Context, pubkey solana.PublicKey) (*TypedAccount, error) {
+	res, err := f.queryClient.Account(ctx, &svmtypes.AccountRequest{Address: pubkey.String()})
+	if err != nil {
+		return nil, fmt.Errorf("get account %s err: %w", pubkey, err)
+	}
+
+	return f.decode(pubkey, res.Account)
+}
+
+func (f *Fetcher) decode(pubkey solana.PublicKey, acc *svmtypes.Account) (*TypedAccount, error) {
+	owner := solana.PublicKeyFromBytes(acc.Owner)
+	name, data, err := f.registry.Decode(owner, acc.Data)
+	if err != nil {
+		return nil, fmt.Errorf("account %s: %w", pubkey, err)
+	}
+
+	return &TypedAccount{
+		Pubkey:     pubkey,
+		Owner:      owner,
+		Lamports:   acc.Lamports,
+		Executable: acc.Executable,
+		Type:       name,
+		Data:       data,
+	}, nil
+}
+
+// GetMultipleTypedAccounts fetches and decodes accounts, in order.
+func (f *Fetcher) GetMultipleTypedAccounts(ctx context.Context, pubkeys ...solana.PublicKey) ([]*TypedAccount, error) {
+	accounts := make([]*TypedAccount, 0, len(pubkeys))
+	for _, pubkey := range pubkeys {
+		acc, err := f.GetTypedAccount(ctx, pubkey)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, acc)
+	}
+	return accounts, nil
+}
+
+// GetProgramAccounts fetches and decodes the accounts owned by program, skipping the
+// accounts of unregistered types.
+func (f *Fetcher) GetProgramAccounts(ctx context.Context, program solana.PublicKey) ([]*TypedAccount, error) {
+	res, err := f.queryClient.AccountsByOwner(ctx, &svmtypes.AccountsByOwnerRequest{Address: program.String()})
+	if err != nil {
+		return nil, fmt.Errorf("get accounts of %s err: %w", program, err)
+	}
+
+	var accounts []*TypedAccount
+	for _, addr := range res.Addresses {
+		pubkey, err := solana.PublicKeyFromBase58(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid account address %s: %w", addr, err)
+		}
+
+		accRes, err := f.queryClient.Account(ctx, &svmtypes.AccountRequest{Address: addr})
+		if err != nil {
+			return nil, fmt.Errorf("get account %s err: %w", addr, err)
+		}
+
+		acc, err := f.decode(pubkey, accRes.Account)
+		if errors.Is(err, ErrUnknownType) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, acc)
+	}
+	return accounts, nil
+}
+
+// Get fetches an account and decodes it as T.
+func Get[T any](ctx context.Context, f *Fetcher, pubkey solana.PublicKey) (*T, error) {
+	acc, err := f.GetTypedAccount(ctx, pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := acc.Data.(*T)
+	if !ok {
+		return nil, fmt.Errorf("account %s is a %s, not a %T", pubkey, acc.Type, *new(T))
+	}
+	return data, nil
+}
+
+// GetAll fetches the accounts of program decoded as T.
+func GetAll[T any](ctx context.Context, f *Fetcher, program solana.PublicKey) (map[solana.PublicKey]*T, error) {
+	accs, err := f.GetProgramAccounts(ctx, program)
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[solana.PublicKey]*T{}
+	for _, acc := range accs {
+		if data, ok := acc.Data.(*T); ok {
+			res[acc.Pubkey] = data
+		}
+	}
+	return res, nil
+}
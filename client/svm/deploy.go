@@ -0,0 +1,297 @@
+package svm
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+)
+
+type DeployStage string
+
+const (
+	DeployStageInit   DeployStage = "init"
+	DeployStageUpload DeployStage = "upload"
+	DeployStageDeploy DeployStage = "deploy"
+)
+
+// DeployProgress reports a landed deployment tx, Done and Total count the program
+// chunks during upload and the txs otherwise.
+type DeployProgress struct {
+	Stage  DeployStage
+	Done   int
+	Total  int
+	TxHash string
+}
+
+type DeployerOptions struct {
+	// ChunkSize is the program bytes written per instruction
+	ChunkSize int
+	// ChunksPerTx is the write instructions per tx
+	ChunksPerTx int
+	// Progress is called after each landed tx
+	Progress func(DeployProgress)
+}
+
+type DeployerOption func(opts *DeployerOptions) error
+
+func DefaultDeployerOptions() *DeployerOptions {
+	return &DeployerOptions{
+		ChunkSize:   1200,
+		ChunksPerTx: 750,
+	}
+}
+
+func OptionChunkSize(size int) DeployerOption {
+	return func(opts *DeployerOptions) error {
+		if size <= 0 {
+			return fmt.Errorf("chunk size must be positive")
+		}
+		opts.ChunkSize = size
+		return nil
+	}
+}
+
+func OptionChunksPerTx(chunks int) DeployerOption {
+	return func(opts *DeployerOptions) error {
+		if chunks <= 0 {
+			return fmt.Errorf("chunks per tx must be positive")
+		}
+		opts.ChunksPerTx = chunks
+		return nil
+	}
+}
+
+func OptionProgress(progress func(DeployProgress)) DeployerOption {
+	return func(opts *DeployerOptions) error {
+		opts.Progress = progress
+		return nil
+	}
+}
+
+// ByteRange is the program bytes [Start, End).
+type ByteRange struct {
+	Start int
+	End   int
+}
+
+// Deployer deploys and upgrades programs with the BPF upgradeable loader. Uploads are
+// resumable: the buffer account is read back and only the chunks not matching the
+// program are written again.
+type Deployer struct {
+	pipeline *TxPipeline
+	signers  []TxSigner
+	opts     *DeployerOptions
+}
+
+// NewDeployer creates a deployer sending txs through pipeline, signers must sign for
+// the cosmos accounts linked to the authority, and to the program and buffer accounts
+// when they are created.
+func NewDeployer(pipeline *TxPipeline, signers []TxSigner, options ...DeployerOption) (*Deployer, error) {
+	opts := DefaultDeployerOptions()
+	for _, o := range options {
+		if err := o(opts); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Deployer{
+		pipeline: pipeline,
+		signers:  signers,
+		opts:     opts,
+	}, nil
+}
+
+// Account returns the svm account, nil if it doesn't exist.
+func (d *Deployer) Account(ctx context.Context, pubkey solana.PublicKey) (*svmtypes.Account, error) {
+	res, err := d.pipeline.queryClient.Account(ctx, &svmtypes.AccountRequest{Address: pubkey.String()})
+	if err != nil {
+		if IsAccountNotExisted(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get account %s err: %w", pubkey, err)
+	}
+	return res.Account, nil
+}
+
+// BufferData returns the content of a buffer account, nil if it doesn't exist.
+func (d *Deployer) BufferData(ctx context.Context, buffer solana.PublicKey) ([]byte, error) {
+	acc, err := d.Account(ctx, buffer)
+	if err != nil || acc == nil {
+		return nil, err
+	}
+	if len(acc.Data) < BufferMetadataSize {
+		return nil, fmt.Errorf("account %s is not a buffer, data has %d bytes", buffer, len(acc.Data))
+	}
+	return acc.Data[BufferMetadataSize:], nil
+}
+
+// MissingRanges compares the buffer content with the program chunk by chunk, returning
+// the chunks to write.
+func (d *Deployer) MissingRanges(ctx context.Context, buffer solana.PublicKey, program []byte) ([]ByteRange, error) {
+	data, err := d.BufferData(ctx, buffer)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, fmt.Errorf("buffer %s doesn't exist", buffer)
+	}
+	if len(data) < len(program) {
+		return nil, fmt.Errorf("buffer %s holds %d bytes, program has %d", buffer, len(data), len(program))
+	}
+
+	var missing []ByteRange
+	for start := 0; start < len(program); start += d.opts.ChunkSize {
+		end := min(start+d.opts.ChunkSize, len(program))
+		if !bytes.Equal(data[start:end], program[start:end]) {
+			missing = append(missing, ByteRange{Start: start, End: end})
+		}
+	}
+	return missing, nil
+}
+
+// VerifyBuffer checks the buffer holds the program by comparing their sha256 hashes.
+func (d *Deployer) VerifyBuffer(ctx context.Context, buffer solana.PublicKey, program []byte) error {
+	data, err := d.BufferData(ctx, buffer)
+	if err != nil {
+		return err
+	}
+	if len(data) < len(program) {
+		return fmt.Errorf("buffer %s holds %d bytes, program has %d", buffer, len(data), len(program))
+	}
+
+	if bufferHash, programHash := sha256.Sum256(data[:len(program)]), sha256.Sum256(program); bufferHash != programHash {
+		return fmt.Errorf("buffer %s hash %x doesn't match program hash %x", buffer, bufferHash, programHash)
+	}
+	return nil
+}
+
+// InitBuffer creates and initializes the buffer account of the program unless it
+// exists, the buffer account must be linked.
+func (d *Deployer) InitBuffer(ctx context.Context, authority, buffer solana.PublicKey, programSize int) error {
+	acc, err := d.Account(ctx, buffer)
+	if err != nil || acc != nil {
+		return err
+	}
+
+	size := uint64(programSize) + 48
+	return d.send(ctx, DeployStageInit, 1, 1,
+		system.NewCreateAccountInstruction(
+			svmtypes.GetRentExemptLamportAmount(size), size,
+			solana.BPFLoaderUpgradeableProgramID, authority, buffer,
+		).Build(),
+		NewInitializeBufferInstruction(buffer, authority),
+	)
+}
+
+// Upload writes the program chunks missing from the buffer, then verifies the buffer.
+// Failed uploads are resumed by calling Upload again.
+func (d *Deployer) Upload(ctx context.Context, authority, buffer solana.PublicKey, program []byte) error {
+	missing, err := d.MissingRanges(ctx, buffer, program)
+	if err != nil {
+		return err
+	}
+
+	total := (len(program) + d.opts.ChunkSize - 1) / d.opts.ChunkSize
+	done := total - len(missing)
+	for start := 0; start < len(missing); start += d.opts.ChunksPerTx {
+		batch := missing[start:min(start+d.opts.ChunksPerTx, len(missing))]
+		ixs := make([]solana.Instruction, 0, len(batch))
+		for _, r := range batch {
+			ixs = append(ixs, NewWriteBufferInstruction(buffer, authority, uint32(r.Start), program[r.Start:r.End]))
+		}
+
+		done += len(batch)
+		if err := d.send(ctx, DeployStageUpload, done, total, ixs...); err != nil {
+			return fmt.Errorf("write chunks %d-%d err: %w", batch[0].Start, batch[len(batch)-1].End, err)
+		}
+	}
+	return d.VerifyBuffer(ctx, buffer, program)
+}
+
+// Deploy deploys the program from a resumable upload: the buffer is created if needed,
+// the missing chunks are written, then the program account is created and deployed.
+// The program and buffer accounts must be linked.
+func (d *Deployer) Deploy(ctx context.Context, authority, program, buffer solana.PublicKey, programBz []byte) error {
+	if err := d.InitBuffer(ctx, authority, buffer, len(programBz)); err != nil {
+		return err
+	}
+	if err := d.Upload(ctx, authority, buffer, programBz); err != nil {
+		return err
+	}
+
+	programData, err := ProgramDataAddress(program)
+	if err != nil {
+		return err
+	}
+
+	var ixs []solana.Instruction
+	acc, err := d.Account(ctx, program)
+	if err != nil {
+		return err
+	}
+	if acc == nil {
+		ixs = append(ixs, system.NewCreateAccountInstruction(
+			svmtypes.GetRentExemptLamportAmount(uint64(ProgramAccountSize)), uint64(ProgramAccountSize),
+			solana.BPFLoaderUpgradeableProgramID, authority, program,
+		).Build())
+	}
+	ixs = append(ixs, NewDeployWithMaxDataLenInstruction(authority, programData, program, buffer, authority, uint64(len(programBz))+48))
+	return d.send(ctx, DeployStageDeploy, 1, 1, ixs...)
+}
+
+// Upgrade uploads the new program code to buffer and upgrades the program with it, the
+// buffer lamports are refunded to the authority.
+func (d *Deployer) Upgrade(ctx context.Context, authority, program, buffer solana.PublicKey, programBz []byte) error {
+	if err := d.InitBuffer(ctx, authority, buffer, len(programBz)); err != nil {
+		return err
+	}
+	if err := d.Upload(ctx, authority, buffer, programBz); err != nil {
+		return err
+	}
+
+	programData, err := ProgramDataAddress(program)
+	if err != nil {
+		return err
+	}
+	return d.send(ctx, DeployStageDeploy, 1, 1, NewUpgradeInstruction(programData, program, buffer, authority, authority))
+}
+
+// SetAuthority hands a buffer or program over to newAuthority. For programs account is
+// the program data account, see ProgramDataAddress. A nil newAuthority makes programs
+// immutable.
+func (d *Deployer) SetAuthority(ctx context.Context, authority, account solana.PublicKey, newAuthority *solana.PublicKey) error {
+	return d.send(ctx, DeployStageDeploy, 1, 1, NewSetAuthorityInstruction(account, authority, newAuthority))
+}
+
+// Close closes a buffer account, reclaiming its rent to recipient.
+func (d *Deployer) Close(ctx context.Context, authority, buffer, recipient solana.PublicKey) error {
+	return d.send(ctx, DeployStageDeploy, 1, 1, NewCloseBufferInstruction(buffer, recipient, authority))
+}
+
+func (d *Deployer) send(ctx context.Context, stage DeployStage, done, total int, ixs ...solana.Instruction) error {
+	res, err := d.pipeline.SendInstructions(ctx, ixs, d.signers...)
+	if err = txError(res, err); err != nil {
+		return err
+	}
+
+	if d.opts.Progress != nil {
+		d.opts.Progress(DeployProgress{Stage: stage, Done: done, Total: total, TxHash: res.TxResponse.TxHash})
+	}
+	return nil
+}
+
+func txError(res *txtypes.BroadcastTxResponse, err error) error {
+	if err != nil {
+		return err
+	}
+	if res.TxResponse.Code != 0 {
+		return fmt.Errorf("tx %s failed with code %d: %s", res.TxResponse.TxHash, res.TxResponse.Code, res.TxResponse.RawLog)
+	}
+	return nil
+}
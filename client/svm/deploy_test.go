@@ -0,0 +1,116 @@
+package svm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fakeQueryClient serves the accounts it holds, other queries are not implemented.
+type fakeQueryClient struct {
+	svmtypes.QueryClient
+	accounts map[string]*svmtypes.Account
+	err      error
+}
+
+func (c *fakeQueryClient) Account(_ context.Context, req *svmtypes.AccountRequest, _ ...grpc.CallOption) (*svmtypes.AccountResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	acc, ok := c.accounts[req.Address]
+	if !ok {
+		return nil, svmtypes.ErrAccountNotExisted
+	}
+	return &svmtypes.AccountResponse{Account: acc}, nil
+}
+
+func newTestDeployer(t *testing.T, queryClient svmtypes.QueryClient, options ...DeployerOption) *Deployer {
+	d, err := NewDeployer(&TxPipeline{queryClient: queryClient}, nil, options...)
+	require.NoError(t, err)
+	return d
+}
+
+// bufferAccount is a buffer holding data after its metadata.
+func bufferAccount(data []byte) *svmtypes.Account {
+	return &svmtypes.Account{
+		Owner: solana.BPFLoaderUpgradeableProgramID[:],
+		Data:  append(make([]byte, BufferMetadataSize), data...),
+	}
+}
+
+func TestMissingRanges(t *testing.T) {
+	program := bytes.Repeat([]byte("0123456789"), 5)
+	buffer := solana.NewWallet().PublicKey()
+
+	testCases := []struct {
+		name    string
+		data    []byte
+		missing []ByteRange
+	}{
+		{"uploaded", program, nil},
+		{"empty", make([]byte, len(program)), []ByteRange{{0, 16}, {16, 32}, {32, 48}, {48, 50}}},
+		{"partly uploaded", append(append([]byte{}, program[:32]...), make([]byte, 18)...), []ByteRange{{32, 48}, {48, 50}}},
+		{"corrupted chunk", append(append(append([]byte{}, program[:20]...), 'x'), program[21:]...), []ByteRange{{16, 32}}},
+		{"larger buffer", append(append([]byte{}, program...), make([]byte, 14)...), nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := newTestDeployer(t, &fakeQueryClient{accounts: map[string]*svmtypes.Account{
+				buffer.String(): bufferAccount(tc.data),
+			}}, OptionChunkSize(16))
+
+			missing, err := d.MissingRanges(context.Background(), buffer, program)
+			require.NoError(t, err)
+			require.Equal(t, tc.missing, missing)
+		})
+	}
+}
+
+func TestMissingRangesErrors(t *testing.T) {
+	program := bytes.Repeat([]byte("0123456789"), 5)
+	buffer := solana.NewWallet().PublicKey()
+	ctx := context.Background()
+
+	d := newTestDeployer(t, &fakeQueryClient{accounts: map[string]*svmtypes.Account{}})
+	_, err := d.MissingRanges(ctx, buffer, program)
+	require.ErrorContains(t, err, "doesn't exist")
+
+	d = newTestDeployer(t, &fakeQueryClient{accounts: map[string]*svmtypes.Account{
+		buffer.String(): bufferAccount(program[:40]),
+	}})
+	_, err = d.MissingRanges(ctx, buffer, program)
+	require.ErrorContains(t, err, "holds 40 bytes, program has 50")
+
+	d = newTestDeployer(t, &fakeQueryClient{accounts: map[string]*svmtypes.Account{
+		buffer.String(): {Data: make([]byte, BufferMetadataSize-1)},
+	}})
+	_, err = d.MissingRanges(ctx, buffer, program)
+	require.ErrorContains(t, err, "is not a buffer")
+
+	queryErr := errors.New("connection refused")
+	d = newTestDeployer(t, &fakeQueryClient{err: queryErr})
+	_, err = d.MissingRanges(ctx, buffer, program)
+	require.ErrorIs(t, err, queryErr)
+}
+
+func TestVerifyBuffer(t *testing.T) {
+	program := bytes.Repeat([]byte("0123456789"), 5)
+	buffer := solana.NewWallet().PublicKey()
+	ctx := context.Background()
+
+	d := newTestDeployer(t, &fakeQueryClient{accounts: map[string]*svmtypes.Account{
+		buffer.String(): bufferAccount(append(append([]byte{}, program...), 0, 0)),
+	}})
+	require.NoError(t, d.VerifyBuffer(ctx, buffer, program))
+
+	corrupted := append([]byte{}, program...)
+	corrupted[49] = 'x'
+	require.ErrorContains(t, d.VerifyBuffer(ctx, buffer, corrupted), "doesn't match program hash")
+}
@@ -0,0 +1,568 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	"github.com/FluxNFTLabs/sdk-go/client/svm"
+	"github.com/FluxNFTLabs/sdk-go/client/svm/accounts"
+	"github.com/FluxNFTLabs/sdk-go/client/svm/token"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/gagliardetto/solana-go"
+)
+
+// Client manages the drift sub-accounts and orders of authority, a linked svm account,
+// sending instructions through a svm.TxPipeline. Instructions loading markets get their
+// markets and oracles appended as remaining accounts.
+type Client struct {
+	pipeline  *svm.TxPipeline
+	fetcher   *accounts.Fetcher
+	authority solana.PublicKey
+	signers   []svm.TxSigner
+}
+
+// NewClient creates a drift client for the program set by SetProgramID, signers sign for
+// the cosmos account linked to authority.
+func NewClient(pipeline *svm.TxPipeline, authority solana.PublicKey, signers ...svm.TxSigner) *Client {
+	registry := accounts.NewRegistry()
+	RegisterAccounts(registry, ProgramID)
+
+	return &Client{
+		pipeline:  pipeline,
+		fetcher:   accounts.NewFetcher(pipeline.QueryClient(), registry),
+		authority: authority,
+		signers:   signers,
+	}
+}
+
+func (c *Client) Authority() solana.PublicKey {
+	return c.authority
+}
+
+// Send sends instructions in a single tx.
+func (c *Client) Send(ctx context.Context, ixs ...solana.Instruction) (*txtypes.BroadcastTxResponse, error) {
+	res, err := c.pipeline.SendInstructions(ctx, ixs, c.signers...)
+	if err != nil {
+		return nil, err
+	}
+	if res.TxResponse.Code != 0 {
+		return res, fmt.Errorf("tx %s failed with code %d: %s", res.TxResponse.TxHash, res.TxResponse.Code, res.TxResponse.RawLog)
+	}
+	return res, nil
+}
+
+// get fetches a drift account as T, nil if it doesn't exist.
+func get[T any](ctx context.Context, c *Client, pubkey solana.PublicKey) (*T, error) {
+	acc, err := accounts.Get[T](ctx, c.fetcher, pubkey)
+	if err != nil {
+		if svm.IsAccountNotExisted(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return acc, nil
+}
+
+func (c *Client) State(ctx context.Context) (*State, error) {
+	addr, err := StateAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := get[State](ctx, c, addr)
+	if err == nil && state == nil {
+		return nil, fmt.Errorf("drift state %s doesn't exist", addr)
+	}
+	return state, err
+}
+
+func (c *Client) SpotMarket(ctx context.Context, marketIndex uint16) (*SpotMarket, error) {
+	addr, err := SpotMarketAddress(marketIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	market, err := get[SpotMarket](ctx, c, addr)
+	if err == nil && market == nil {
+		return nil, fmt.Errorf("spot market %d doesn't exist", marketIndex)
+	}
+	return market, err
+}
+
+func (c *Client) PerpMarket(ctx context.Context, marketIndex uint16) (*PerpMarket, error) {
+	addr, err := PerpMarketAddress(marketIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	market, err := get[PerpMarket](ctx, c, addr)
+	if err == nil && market == nil {
+		return nil, fmt.Errorf("perp market %d doesn't exist", marketIndex)
+	}
+	return market, err
+}
+
+// User returns a sub-account of authority, nil if it doesn't exist.
+func (c *Client) User(ctx context.Context, subAccountId uint16) (*User, error) {
+	addr, err := UserAddress(c.authority, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	return get[User](ctx, c, addr)
+}
+
+// UserStats returns the stats of authority, nil if they don't exist.
+func (c *Client) UserStats(ctx context.Context) (*UserStats, error) {
+	addr, err := UserStatsAddress(c.authority)
+	if err != nil {
+		return nil, err
+	}
+	return get[UserStats](ctx, c, addr)
+}
+
+// SubAccounts returns the existing sub-accounts of authority by id.
+func (c *Client) SubAccounts(ctx context.Context) (map[uint16]*User, error) {
+	stats, err := c.UserStats(ctx)
+	if err != nil || stats == nil {
+		return nil, err
+	}
+
+	users := map[uint16]*User{}
+	// deleted sub-accounts leave gaps in the ids
+	for id := uint16(0); id < stats.NumberOfSubAccountsCreated; id++ {
+		user, err := c.User(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if user != nil {
+			users[id] = user
+		}
+	}
+	return users, nil
+}
+
+func (c *Client) mustUser(ctx context.Context, subAccountId uint16) (*User, error) {
+	user, err := c.User(ctx, subAccountId)
+	if err == nil && user == nil {
+		return nil, fmt.Errorf("sub-account %d of %s doesn't exist", subAccountId, c.authority)
+	}
+	return user, err
+}
+
+// initializeUserInstructions returns the instructions creating sub-account subAccountId,
+// preceded by the creation of the user stats when they don't exist.
+func (c *Client) initializeUserInstructions(ctx context.Context, subAccountId uint16, name string) ([]solana.Instruction, error) {
+	state, err := StateAddress()
+	if err != nil {
+		return nil, err
+	}
+	userAddr, err := UserAddress(c.authority, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	userStatsAddr, err := UserStatsAddress(c.authority)
+	if err != nil {
+		return nil, err
+	}
+
+	var ixs []solana.Instruction
+	stats, err := c.UserStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if stats == nil {
+		ixs = append(ixs, NewInitializeUserStatsInstruction(
+			userStatsAddr, state, c.authority, c.authority,
+			solana.PublicKey(svmtypes.SysVarRent), svmtypes.SystemProgramId,
+		).Build())
+	}
+
+	return append(ixs, NewInitializeUserInstruction(
+		subAccountId, EncodeName(name),
+		userAddr, userStatsAddr, state, c.authority, c.authority,
+		solana.PublicKey(svmtypes.SysVarRent), svmtypes.SystemProgramId,
+	).Build()), nil
+}
+
+// InitializeUser creates sub-account subAccountId unless it exists, creating the user
+// stats first if needed. Drift creates sub-accounts in order, subAccountId must be the
+// number of sub-accounts created so far.
+func (c *Client) InitializeUser(ctx context.Context, subAccountId uint16, name string) error {
+	user, err := c.User(ctx, subAccountId)
+	if err != nil || user != nil {
+		return err
+	}
+
+	ixs, err := c.initializeUserInstructions(ctx, subAccountId, name)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Send(ctx, ixs...)
+	return err
+}
+
+// CreateSubAccount creates the next sub-account, returning its id.
+func (c *Client) CreateSubAccount(ctx context.Context, name string) (uint16, error) {
+	stats, err := c.UserStats(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	subAccountId := uint16(0)
+	if stats != nil {
+		subAccountId = stats.NumberOfSubAccountsCreated
+	}
+	return subAccountId, c.InitializeUser(ctx, subAccountId, name)
+}
+
+// DeleteUser deletes an empty sub-account, reclaiming its rent.
+func (c *Client) DeleteUser(ctx context.Context, subAccountId uint16) (*txtypes.BroadcastTxResponse, error) {
+	state, err := StateAddress()
+	if err != nil {
+		return nil, err
+	}
+	userAddr, err := UserAddress(c.authority, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	userStatsAddr, err := UserStatsAddress(c.authority)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Send(ctx, NewDeleteUserInstruction(userAddr, userStatsAddr, state, c.authority).Build())
+}
+
+// spotTokenAccounts returns the vault of a spot market, the associated token account of
+// authority for its mint and the token program owning the mint.
+func (c *Client) spotTokenAccounts(ctx context.Context, market *SpotMarket) (vault, userTokenAccount, tokenProgram solana.PublicKey, err error) {
+	res, err := c.pipeline.QueryClient().Account(ctx, &svmtypes.AccountRequest{Address: market.Mint.String()})
+	if err != nil {
+		err = fmt.Errorf("get mint %s err: %w", market.Mint, err)
+		return
+	}
+	tokenProgram = solana.PublicKeyFromBytes(res.Account.Owner)
+
+	vault, err = SpotMarketVaultAddress(market.MarketIndex)
+	if err != nil {
+		return
+	}
+	userTokenAccount, err = token.FindAta(c.authority, market.Mint, tokenProgram)
+	return
+}
+
+// Deposit deposits amount from the associated token account of authority into spot
+// market marketIndex, creating the sub-account if it doesn't exist.
+func (c *Client) Deposit(ctx context.Context, subAccountId, marketIndex uint16, amount uint64, reduceOnly bool) (*txtypes.BroadcastTxResponse, error) {
+	market, err := c.SpotMarket(ctx, marketIndex)
+	if err != nil {
+		return nil, err
+	}
+	vault, userTokenAccount, tokenProgram, err := c.spotTokenAccounts(ctx, market)
+	if err != nil {
+		return nil, err
+	}
+
+	var ixs []solana.Instruction
+	user, err := c.User(ctx, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		ixs, err = c.initializeUserInstructions(ctx, subAccountId, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	remaining := c.newRemainingAccounts()
+	if err := remaining.addUser(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := remaining.addSpotMarket(ctx, marketIndex, true); err != nil {
+		return nil, err
+	}
+
+	state, err := StateAddress()
+	if err != nil {
+		return nil, err
+	}
+	userAddr, err := UserAddress(c.authority, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	userStatsAddr, err := UserStatsAddress(c.authority)
+	if err != nil {
+		return nil, err
+	}
+
+	ix := NewDepositInstruction(
+		marketIndex, amount, reduceOnly,
+		state, userAddr, userStatsAddr, c.authority, vault, userTokenAccount, tokenProgram,
+	)
+	ix.AccountMetaSlice = append(ix.AccountMetaSlice, remaining.metas()...)
+	return c.Send(ctx, append(ixs, ix.Build())...)
+}
+
+// Withdraw withdraws amount of spot market marketIndex to the associated token account
+// of authority, created if needed. Without reduceOnly, withdrawing more than the deposit
+// borrows.
+func (c *Client) Withdraw(ctx context.Context, subAccountId, marketIndex uint16, amount uint64, reduceOnly bool) (*txtypes.BroadcastTxResponse, error) {
+	market, err := c.SpotMarket(ctx, marketIndex)
+	if err != nil {
+		return nil, err
+	}
+	vault, userTokenAccount, tokenProgram, err := c.spotTokenAccounts(ctx, market)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := c.mustUser(ctx, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := c.newRemainingAccounts()
+	if err := remaining.addUser(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := remaining.addSpotMarket(ctx, marketIndex, true); err != nil {
+		return nil, err
+	}
+
+	state, err := StateAddress()
+	if err != nil {
+		return nil, err
+	}
+	signer, err := SignerAddress()
+	if err != nil {
+		return nil, err
+	}
+	userAddr, err := UserAddress(c.authority, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	userStatsAddr, err := UserStatsAddress(c.authority)
+	if err != nil {
+		return nil, err
+	}
+
+	createAta, err := token.NewCreateAtaInstruction(c.authority, c.authority, market.Mint, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+	ix := NewWithdrawInstruction(
+		marketIndex, amount, reduceOnly,
+		state, userAddr, userStatsAddr, c.authority, vault, signer, userTokenAccount, tokenProgram,
+	)
+	ix.AccountMetaSlice = append(ix.AccountMetaSlice, remaining.metas()...)
+	return c.Send(ctx, createAta, ix.Build())
+}
+
+// userInstructionAccounts returns the state, user and authority accounts of the order
+// instructions.
+func (c *Client) userInstructionAccounts(subAccountId uint16) (state, user solana.PublicKey, err error) {
+	state, err = StateAddress()
+	if err != nil {
+		return
+	}
+	user, err = UserAddress(c.authority, subAccountId)
+	return
+}
+
+// userRemainingAccounts returns the remaining accounts with the markets of the sub-account.
+func (c *Client) userRemainingAccounts(ctx context.Context, subAccountId uint16) (*remainingAccounts, error) {
+	user, err := c.mustUser(ctx, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := c.newRemainingAccounts()
+	if err := remaining.addUser(ctx, user); err != nil {
+		return nil, err
+	}
+	return remaining, nil
+}
+
+// addOrderMarket adds the market of an order, with the quote market for spot orders.
+func (r *remainingAccounts) addOrderMarket(ctx context.Context, params OrderParams) error {
+	if params.MarketType == MarketTypePerp {
+		return r.addPerpMarket(ctx, params.MarketIndex, false)
+	}
+	if err := r.addSpotMarket(ctx, QuoteSpotMarketIndex, false); err != nil {
+		return err
+	}
+	return r.addSpotMarket(ctx, params.MarketIndex, false)
+}
+
+// PlacePerpOrder places a perp order, params.MarketType is set to perp.
+func (c *Client) PlacePerpOrder(ctx context.Context, subAccountId uint16, params OrderParams) (*txtypes.BroadcastTxResponse, error) {
+	params.MarketType = MarketTypePerp
+	remaining, err := c.userRemainingAccounts(ctx, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	if err := remaining.addOrderMarket(ctx, params); err != nil {
+		return nil, err
+	}
+
+	state, user, err := c.userInstructionAccounts(subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	ix := NewPlacePerpOrderInstruction(params, state, user, c.authority)
+	ix.AccountMetaSlice = append(ix.AccountMetaSlice, remaining.metas()...)
+	return c.Send(ctx, ix.Build())
+}
+
+// PlaceSpotOrder places a spot order, params.MarketType is set to spot.
+func (c *Client) PlaceSpotOrder(ctx context.Context, subAccountId uint16, params OrderParams) (*txtypes.BroadcastTxResponse, error) {
+	params.MarketType = MarketTypeSpot
+	remaining, err := c.userRemainingAccounts(ctx, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	if err := remaining.addOrderMarket(ctx, params); err != nil {
+		return nil, err
+	}
+
+	state, user, err := c.userInstructionAccounts(subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	ix := NewPlaceSpotOrderInstruction(params, state, user, c.authority)
+	ix.AccountMetaSlice = append(ix.AccountMetaSlice, remaining.metas()...)
+	return c.Send(ctx, ix.Build())
+}
+
+// PlaceOrders places spot and perp orders in a single instruction.
+func (c *Client) PlaceOrders(ctx context.Context, subAccountId uint16, params []OrderParams) (*txtypes.BroadcastTxResponse, error) {
+	remaining, err := c.userRemainingAccounts(ctx, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range params {
+		if err := remaining.addOrderMarket(ctx, p); err != nil {
+			return nil, err
+		}
+	}
+
+	state, user, err := c.userInstructionAccounts(subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	ix := NewPlaceOrdersInstruction(params, state, user, c.authority)
+	ix.AccountMetaSlice = append(ix.AccountMetaSlice, remaining.metas()...)
+	return c.Send(ctx, ix.Build())
+}
+
+// CancelOrder cancels the order orderId of the sub-account.
+func (c *Client) CancelOrder(ctx context.Context, subAccountId uint16, orderId uint32) (*txtypes.BroadcastTxResponse, error) {
+	remaining, err := c.userRemainingAccounts(ctx, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	state, user, err := c.userInstructionAccounts(subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	ix := NewCancelOrderInstruction(orderId, state, user, c.authority)
+	ix.AccountMetaSlice = append(ix.AccountMetaSlice, remaining.metas()...)
+	return c.Send(ctx, ix.Build())
+}
+
+func (c *Client) CancelOrderByUserId(ctx context.Context, subAccountId uint16, userOrderId uint8) (*txtypes.BroadcastTxResponse, error) {
+	remaining, err := c.userRemainingAccounts(ctx, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	state, user, err := c.userInstructionAccounts(subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	ix := NewCancelOrderByUserIdInstruction(userOrderId, state, user, c.authority)
+	ix.AccountMetaSlice = append(ix.AccountMetaSlice, remaining.metas()...)
+	return c.Send(ctx, ix.Build())
+}
+
+// CancelOrders cancels the open orders of the sub-account matching the set filters, all
+// of them when no filter is set.
+func (c *Client) CancelOrders(
+	ctx context.Context,
+	subAccountId uint16,
+	marketType *MarketType,
+	marketIndex *uint16,
+	direction *PositionDirection,
+) (*txtypes.BroadcastTxResponse, error) {
+	remaining, err := c.userRemainingAccounts(ctx, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	state, user, err := c.userInstructionAccounts(subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	ix := NewCancelOrdersInstructionBuilder().
+		SetStateAccount(state).
+		SetUserAccount(user).
+		SetAuthorityAccount(c.authority)
+	ix.MarketType = marketType
+	ix.MarketIndex = marketIndex
+	ix.Direction = direction
+	ix.AccountMetaSlice = append(ix.AccountMetaSlice, remaining.metas()...)
+	return c.Send(ctx, ix.Build())
+}
+
+// ModifyOrder modifies the set fields of the order orderId.
+func (c *Client) ModifyOrder(ctx context.Context, subAccountId uint16, orderId uint32, params ModifyOrderParams) (*txtypes.BroadcastTxResponse, error) {
+	remaining, err := c.userRemainingAccounts(ctx, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	state, user, err := c.userInstructionAccounts(subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	ix := NewModifyOrderInstruction(orderId, params, state, user, c.authority)
+	ix.AccountMetaSlice = append(ix.AccountMetaSlice, remaining.metas()...)
+	return c.Send(ctx, ix.Build())
+}
+
+func (c *Client) ModifyOrderByUserId(ctx context.Context, subAccountId uint16, userOrderId uint8, params ModifyOrderParams) (*txtypes.BroadcastTxResponse, error) {
+	remaining, err := c.userRemainingAccounts(ctx, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	state, user, err := c.userInstructionAccounts(subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	ix := NewModifyOrderByUserIdInstruction(userOrderId, params, state, user, c.authority)
+	ix.AccountMetaSlice = append(ix.AccountMetaSlice, remaining.metas()...)
+	return c.Send(ctx, ix.Build())
+}
+
+// OpenOrders returns the open orders of the sub-account.
+func (c *Client) OpenOrders(ctx context.Context, subAccountId uint16) ([]Order, error) {
+	user, err := c.mustUser(ctx, subAccountId)
+	if err != nil {
+		return nil, err
+	}
+	return user.GetOpenOrders(), nil
+}
+
+// Positions returns the active spot and perp positions of the sub-account.
+func (c *Client) Positions(ctx context.Context, subAccountId uint16) ([]SpotPosition, []PerpPosition, error) {
+	user, err := c.mustUser(ctx, subAccountId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user.ActiveSpotPositions(), user.ActivePerpPositions(), nil
+}
@@ -0,0 +1,15 @@
+# install 
+# go install https://github.com/gagliardetto/anchor-go@latest
+anchor-go --src=../../../examples/chain/38_DeployDriftDEX/idl/drift.json --dst=.
+
+# anchor-go gives NewXInstruction the same param name for an account and an instruction
+# param of the same name, suffix the account one with Account
+rename_account_param() {
+	sed -i \
+		-e "/^	\/\/ Accounts:$/,/^	return /s/^	$2 ag_solanago\.PublicKey/	$2Account ag_solanago.PublicKey/" \
+		-e "s/Set$3Account($2)/Set$3Account($2Account)/" \
+		$1
+}
+rename_account_param UpdateAdmin.go admin Admin
+rename_account_param UpdatePerpMarketOracle.go oracle Oracle
+rename_account_param UpdateSpotMarketOracle.go oracle Oracle
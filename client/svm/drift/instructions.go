@@ -722,39 +722,498 @@ var InstructionImplDef = ag_binary.NewVariantDefinition(
 		{
 			"initialize_user_stats", (*InitializeUserStats)(nil),
 		},
+		{
+			"initialize_referrer_name", (*InitializeReferrerName)(nil),
+		},
 		{
 			"deposit", (*Deposit)(nil),
 		},
 		{
 			"withdraw", (*Withdraw)(nil),
 		},
+		{
+			"transfer_deposit", (*TransferDeposit)(nil),
+		},
 		{
 			"place_perp_order", (*PlacePerpOrder)(nil),
 		},
+		{
+			"cancel_order", (*CancelOrder)(nil),
+		},
+		{
+			"cancel_order_by_user_id", (*CancelOrderByUserId)(nil),
+		},
+		{
+			"cancel_orders", (*CancelOrders)(nil),
+		},
+		{
+			"cancel_orders_by_ids", (*CancelOrdersByIds)(nil),
+		},
+		{
+			"modify_order", (*ModifyOrder)(nil),
+		},
+		{
+			"modify_order_by_user_id", (*ModifyOrderByUserId)(nil),
+		},
+		{
+			"place_and_take_perp_order", (*PlaceAndTakePerpOrder)(nil),
+		},
+		{
+			"place_and_make_perp_order", (*PlaceAndMakePerpOrder)(nil),
+		},
 		{
 			"place_spot_order", (*PlaceSpotOrder)(nil),
 		},
 		{
-			"fill_perp_order", (*FillPerpOrder)(nil),
+			"place_and_take_spot_order", (*PlaceAndTakeSpotOrder)(nil),
 		},
 		{
 			"place_and_make_spot_order", (*PlaceAndMakeSpotOrder)(nil),
 		},
 		{
-			"place_and_make_perp_order", (*PlaceAndMakePerpOrder)(nil),
+			"place_orders", (*PlaceOrders)(nil),
+		},
+		{
+			"begin_swap", (*BeginSwap)(nil),
+		},
+		{
+			"end_swap", (*EndSwap)(nil),
+		},
+		{
+			"add_perp_lp_shares", (*AddPerpLpShares)(nil),
+		},
+		{
+			"remove_perp_lp_shares", (*RemovePerpLpShares)(nil),
+		},
+		{
+			"remove_perp_lp_shares_in_expiring_market", (*RemovePerpLpSharesInExpiringMarket)(nil),
+		},
+		{
+			"update_user_name", (*UpdateUserName)(nil),
+		},
+		{
+			"update_user_custom_margin_ratio", (*UpdateUserCustomMarginRatio)(nil),
+		},
+		{
+			"update_user_margin_trading_enabled", (*UpdateUserMarginTradingEnabled)(nil),
+		},
+		{
+			"update_user_delegate", (*UpdateUserDelegate)(nil),
+		},
+		{
+			"update_user_reduce_only", (*UpdateUserReduceOnly)(nil),
+		},
+		{
+			"update_user_advanced_lp", (*UpdateUserAdvancedLp)(nil),
+		},
+		{
+			"delete_user", (*DeleteUser)(nil),
+		},
+		{
+			"reclaim_rent", (*ReclaimRent)(nil),
+		},
+		{
+			"fill_perp_order", (*FillPerpOrder)(nil),
+		},
+		{
+			"revert_fill", (*RevertFill)(nil),
 		},
 		{
 			"fill_spot_order", (*FillSpotOrder)(nil),
 		},
+		{
+			"trigger_order", (*TriggerOrder)(nil),
+		},
+		{
+			"force_cancel_orders", (*ForceCancelOrders)(nil),
+		},
+		{
+			"update_user_idle", (*UpdateUserIdle)(nil),
+		},
+		{
+			"update_user_open_orders_count", (*UpdateUserOpenOrdersCount)(nil),
+		},
+		{
+			"admin_disable_update_perp_bid_ask_twap", (*AdminDisableUpdatePerpBidAskTwap)(nil),
+		},
+		{
+			"settle_pnl", (*SettlePnl)(nil),
+		},
+		{
+			"settle_multiple_pnls", (*SettleMultiplePnls)(nil),
+		},
+		{
+			"settle_funding_payment", (*SettleFundingPayment)(nil),
+		},
+		{
+			"settle_lp", (*SettleLp)(nil),
+		},
+		{
+			"settle_expired_market", (*SettleExpiredMarket)(nil),
+		},
+		{
+			"liquidate_perp", (*LiquidatePerp)(nil),
+		},
+		{
+			"liquidate_perp_with_fill", (*LiquidatePerpWithFill)(nil),
+		},
+		{
+			"liquidate_spot", (*LiquidateSpot)(nil),
+		},
+		{
+			"liquidate_borrow_for_perp_pnl", (*LiquidateBorrowForPerpPnl)(nil),
+		},
+		{
+			"liquidate_perp_pnl_for_deposit", (*LiquidatePerpPnlForDeposit)(nil),
+		},
+		{
+			"resolve_perp_pnl_deficit", (*ResolvePerpPnlDeficit)(nil),
+		},
+		{
+			"resolve_perp_bankruptcy", (*ResolvePerpBankruptcy)(nil),
+		},
+		{
+			"resolve_spot_bankruptcy", (*ResolveSpotBankruptcy)(nil),
+		},
+		{
+			"settle_revenue_to_insurance_fund", (*SettleRevenueToInsuranceFund)(nil),
+		},
+		{
+			"update_funding_rate", (*UpdateFundingRate)(nil),
+		},
+		{
+			"update_prelaunch_oracle", (*UpdatePrelaunchOracle)(nil),
+		},
+		{
+			"update_perp_bid_ask_twap", (*UpdatePerpBidAskTwap)(nil),
+		},
+		{
+			"update_spot_market_cumulative_interest", (*UpdateSpotMarketCumulativeInterest)(nil),
+		},
+		{
+			"update_amms", (*UpdateAmms)(nil),
+		},
+		{
+			"update_spot_market_expiry", (*UpdateSpotMarketExpiry)(nil),
+		},
+		{
+			"update_user_quote_asset_insurance_stake", (*UpdateUserQuoteAssetInsuranceStake)(nil),
+		},
+		{
+			"update_user_gov_token_insurance_stake", (*UpdateUserGovTokenInsuranceStake)(nil),
+		},
+		{
+			"initialize_insurance_fund_stake", (*InitializeInsuranceFundStake)(nil),
+		},
+		{
+			"add_insurance_fund_stake", (*AddInsuranceFundStake)(nil),
+		},
+		{
+			"request_remove_insurance_fund_stake", (*RequestRemoveInsuranceFundStake)(nil),
+		},
+		{
+			"cancel_request_remove_insurance_fund_stake", (*CancelRequestRemoveInsuranceFundStake)(nil),
+		},
+		{
+			"remove_insurance_fund_stake", (*RemoveInsuranceFundStake)(nil),
+		},
+		{
+			"transfer_protocol_if_shares", (*TransferProtocolIfShares)(nil),
+		},
+		{
+			"update_pyth_pull_oracle", (*UpdatePythPullOracle)(nil),
+		},
+		{
+			"post_pyth_pull_oracle_update_atomic", (*PostPythPullOracleUpdateAtomic)(nil),
+		},
+		{
+			"post_multi_pyth_pull_oracle_updates_atomic", (*PostMultiPythPullOracleUpdatesAtomic)(nil),
+		},
 		{
 			"initialize", (*Initialize)(nil),
 		},
 		{
 			"initialize_spot_market", (*InitializeSpotMarket)(nil),
 		},
+		{
+			"delete_initialized_spot_market", (*DeleteInitializedSpotMarket)(nil),
+		},
+		{
+			"initialize_serum_fulfillment_config", (*InitializeSerumFulfillmentConfig)(nil),
+		},
+		{
+			"update_serum_fulfillment_config_status", (*UpdateSerumFulfillmentConfigStatus)(nil),
+		},
+		{
+			"initialize_openbook_v2_fulfillment_config", (*InitializeOpenbookV2FulfillmentConfig)(nil),
+		},
+		{
+			"openbook_v2_fulfillment_config_status", (*OpenbookV2FulfillmentConfigStatus)(nil),
+		},
+		{
+			"initialize_phoenix_fulfillment_config", (*InitializePhoenixFulfillmentConfig)(nil),
+		},
+		{
+			"phoenix_fulfillment_config_status", (*PhoenixFulfillmentConfigStatus)(nil),
+		},
+		{
+			"update_serum_vault", (*UpdateSerumVault)(nil),
+		},
 		{
 			"initialize_perp_market", (*InitializePerpMarket)(nil),
 		},
+		{
+			"initialize_prediction_market", (*InitializePredictionMarket)(nil),
+		},
+		{
+			"delete_initialized_perp_market", (*DeleteInitializedPerpMarket)(nil),
+		},
+		{
+			"move_amm_price", (*MoveAmmPrice)(nil),
+		},
+		{
+			"recenter_perp_market_amm", (*RecenterPerpMarketAmm)(nil),
+		},
+		{
+			"update_perp_market_amm_summary_stats", (*UpdatePerpMarketAmmSummaryStats)(nil),
+		},
+		{
+			"update_perp_market_expiry", (*UpdatePerpMarketExpiry)(nil),
+		},
+		{
+			"settle_expired_market_pools_to_revenue_pool", (*SettleExpiredMarketPoolsToRevenuePool)(nil),
+		},
+		{
+			"deposit_into_perp_market_fee_pool", (*DepositIntoPerpMarketFeePool)(nil),
+		},
+		{
+			"deposit_into_spot_market_vault", (*DepositIntoSpotMarketVault)(nil),
+		},
+		{
+			"deposit_into_spot_market_revenue_pool", (*DepositIntoSpotMarketRevenuePool)(nil),
+		},
+		{
+			"repeg_amm_curve", (*RepegAmmCurve)(nil),
+		},
+		{
+			"update_perp_market_amm_oracle_twap", (*UpdatePerpMarketAmmOracleTwap)(nil),
+		},
+		{
+			"reset_perp_market_amm_oracle_twap", (*ResetPerpMarketAmmOracleTwap)(nil),
+		},
+		{
+			"update_k", (*UpdateK)(nil),
+		},
+		{
+			"update_perp_market_margin_ratio", (*UpdatePerpMarketMarginRatio)(nil),
+		},
+		{
+			"update_perp_market_funding_period", (*UpdatePerpMarketFundingPeriod)(nil),
+		},
+		{
+			"update_perp_market_max_imbalances", (*UpdatePerpMarketMaxImbalances)(nil),
+		},
+		{
+			"update_perp_market_liquidation_fee", (*UpdatePerpMarketLiquidationFee)(nil),
+		},
+		{
+			"update_insurance_fund_unstaking_period", (*UpdateInsuranceFundUnstakingPeriod)(nil),
+		},
+		{
+			"update_spot_market_liquidation_fee", (*UpdateSpotMarketLiquidationFee)(nil),
+		},
+		{
+			"update_withdraw_guard_threshold", (*UpdateWithdrawGuardThreshold)(nil),
+		},
+		{
+			"update_spot_market_if_factor", (*UpdateSpotMarketIfFactor)(nil),
+		},
+		{
+			"update_spot_market_revenue_settle_period", (*UpdateSpotMarketRevenueSettlePeriod)(nil),
+		},
+		{
+			"update_spot_market_status", (*UpdateSpotMarketStatus)(nil),
+		},
+		{
+			"update_spot_market_paused_operations", (*UpdateSpotMarketPausedOperations)(nil),
+		},
+		{
+			"update_spot_market_asset_tier", (*UpdateSpotMarketAssetTier)(nil),
+		},
+		{
+			"update_spot_market_margin_weights", (*UpdateSpotMarketMarginWeights)(nil),
+		},
+		{
+			"update_spot_market_borrow_rate", (*UpdateSpotMarketBorrowRate)(nil),
+		},
+		{
+			"update_spot_market_max_token_deposits", (*UpdateSpotMarketMaxTokenDeposits)(nil),
+		},
+		{
+			"update_spot_market_max_token_borrows", (*UpdateSpotMarketMaxTokenBorrows)(nil),
+		},
+		{
+			"update_spot_market_scale_initial_asset_weight_start", (*UpdateSpotMarketScaleInitialAssetWeightStart)(nil),
+		},
+		{
+			"update_spot_market_oracle", (*UpdateSpotMarketOracle)(nil),
+		},
+		{
+			"update_spot_market_step_size_and_tick_size", (*UpdateSpotMarketStepSizeAndTickSize)(nil),
+		},
+		{
+			"update_spot_market_min_order_size", (*UpdateSpotMarketMinOrderSize)(nil),
+		},
+		{
+			"update_spot_market_orders_enabled", (*UpdateSpotMarketOrdersEnabled)(nil),
+		},
+		{
+			"update_spot_market_if_paused_operations", (*UpdateSpotMarketIfPausedOperations)(nil),
+		},
+		{
+			"update_spot_market_name", (*UpdateSpotMarketName)(nil),
+		},
+		{
+			"update_perp_market_status", (*UpdatePerpMarketStatus)(nil),
+		},
+		{
+			"update_perp_market_paused_operations", (*UpdatePerpMarketPausedOperations)(nil),
+		},
+		{
+			"update_perp_market_contract_tier", (*UpdatePerpMarketContractTier)(nil),
+		},
+		{
+			"update_perp_market_imf_factor", (*UpdatePerpMarketImfFactor)(nil),
+		},
+		{
+			"update_perp_market_unrealized_asset_weight", (*UpdatePerpMarketUnrealizedAssetWeight)(nil),
+		},
+		{
+			"update_perp_market_concentration_coef", (*UpdatePerpMarketConcentrationCoef)(nil),
+		},
+		{
+			"update_perp_market_curve_update_intensity", (*UpdatePerpMarketCurveUpdateIntensity)(nil),
+		},
+		{
+			"update_perp_market_target_base_asset_amount_per_lp", (*UpdatePerpMarketTargetBaseAssetAmountPerLp)(nil),
+		},
+		{
+			"update_perp_market_per_lp_base", (*UpdatePerpMarketPerLpBase)(nil),
+		},
+		{
+			"update_lp_cooldown_time", (*UpdateLpCooldownTime)(nil),
+		},
+		{
+			"update_perp_fee_structure", (*UpdatePerpFeeStructure)(nil),
+		},
+		{
+			"update_spot_fee_structure", (*UpdateSpotFeeStructure)(nil),
+		},
+		{
+			"update_initial_pct_to_liquidate", (*UpdateInitialPctToLiquidate)(nil),
+		},
+		{
+			"update_liquidation_duration", (*UpdateLiquidationDuration)(nil),
+		},
+		{
+			"update_liquidation_margin_buffer_ratio", (*UpdateLiquidationMarginBufferRatio)(nil),
+		},
+		{
+			"update_oracle_guard_rails", (*UpdateOracleGuardRails)(nil),
+		},
+		{
+			"update_state_settlement_duration", (*UpdateStateSettlementDuration)(nil),
+		},
+		{
+			"update_state_max_number_of_sub_accounts", (*UpdateStateMaxNumberOfSubAccounts)(nil),
+		},
+		{
+			"update_state_max_initialize_user_fee", (*UpdateStateMaxInitializeUserFee)(nil),
+		},
+		{
+			"update_perp_market_oracle", (*UpdatePerpMarketOracle)(nil),
+		},
+		{
+			"update_perp_market_base_spread", (*UpdatePerpMarketBaseSpread)(nil),
+		},
+		{
+			"update_amm_jit_intensity", (*UpdateAmmJitIntensity)(nil),
+		},
+		{
+			"update_perp_market_max_spread", (*UpdatePerpMarketMaxSpread)(nil),
+		},
+		{
+			"update_perp_market_step_size_and_tick_size", (*UpdatePerpMarketStepSizeAndTickSize)(nil),
+		},
+		{
+			"update_perp_market_name", (*UpdatePerpMarketName)(nil),
+		},
+		{
+			"update_perp_market_min_order_size", (*UpdatePerpMarketMinOrderSize)(nil),
+		},
+		{
+			"update_perp_market_max_slippage_ratio", (*UpdatePerpMarketMaxSlippageRatio)(nil),
+		},
+		{
+			"update_perp_market_max_fill_reserve_fraction", (*UpdatePerpMarketMaxFillReserveFraction)(nil),
+		},
+		{
+			"update_perp_market_max_open_interest", (*UpdatePerpMarketMaxOpenInterest)(nil),
+		},
+		{
+			"update_perp_market_number_of_users", (*UpdatePerpMarketNumberOfUsers)(nil),
+		},
+		{
+			"update_perp_market_fee_adjustment", (*UpdatePerpMarketFeeAdjustment)(nil),
+		},
+		{
+			"update_spot_market_fee_adjustment", (*UpdateSpotMarketFeeAdjustment)(nil),
+		},
+		{
+			"update_perp_market_fuel", (*UpdatePerpMarketFuel)(nil),
+		},
+		{
+			"update_spot_market_fuel", (*UpdateSpotMarketFuel)(nil),
+		},
+		{
+			"init_user_fuel", (*InitUserFuel)(nil),
+		},
+		{
+			"update_admin", (*UpdateAdmin)(nil),
+		},
+		{
+			"update_whitelist_mint", (*UpdateWhitelistMint)(nil),
+		},
+		{
+			"update_discount_mint", (*UpdateDiscountMint)(nil),
+		},
+		{
+			"update_exchange_status", (*UpdateExchangeStatus)(nil),
+		},
+		{
+			"update_perp_auction_duration", (*UpdatePerpAuctionDuration)(nil),
+		},
+		{
+			"update_spot_auction_duration", (*UpdateSpotAuctionDuration)(nil),
+		},
+		{
+			"initialize_protocol_if_shares_transfer_config", (*InitializeProtocolIfSharesTransferConfig)(nil),
+		},
+		{
+			"update_protocol_if_shares_transfer_config", (*UpdateProtocolIfSharesTransferConfig)(nil),
+		},
+		{
+			"initialize_prelaunch_oracle", (*InitializePrelaunchOracle)(nil),
+		},
+		{
+			"update_prelaunch_oracle_params", (*UpdatePrelaunchOracleParams)(nil),
+		},
+		{
+			"delete_prelaunch_oracle", (*DeletePrelaunchOracle)(nil),
+		},
+		{
+			"initialize_pyth_pull_oracle", (*InitializePythPullOracle)(nil),
+		},
 	},
 )
 
@@ -0,0 +1,51 @@
+package drift
+
+import (
+	"encoding/binary"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// PDAs of the drift program set by SetProgramID.
+
+func findAddress(seeds ...[]byte) (solana.PublicKey, error) {
+	addr, _, err := solana.FindProgramAddress(seeds, ProgramID)
+	return addr, err
+}
+
+func indexSeed(index uint16) []byte {
+	return binary.LittleEndian.AppendUint16(nil, index)
+}
+
+func StateAddress() (solana.PublicKey, error) {
+	return findAddress([]byte("drift_state"))
+}
+
+// SignerAddress returns the drift signer, authority of the market vaults.
+func SignerAddress() (solana.PublicKey, error) {
+	return findAddress([]byte("drift_signer"))
+}
+
+func UserAddress(authority solana.PublicKey, subAccountId uint16) (solana.PublicKey, error) {
+	return findAddress([]byte("user"), authority[:], indexSeed(subAccountId))
+}
+
+func UserStatsAddress(authority solana.PublicKey) (solana.PublicKey, error) {
+	return findAddress([]byte("user_stats"), authority[:])
+}
+
+func SpotMarketAddress(marketIndex uint16) (solana.PublicKey, error) {
+	return findAddress([]byte("spot_market"), indexSeed(marketIndex))
+}
+
+func SpotMarketVaultAddress(marketIndex uint16) (solana.PublicKey, error) {
+	return findAddress([]byte("spot_market_vault"), indexSeed(marketIndex))
+}
+
+func InsuranceFundVaultAddress(marketIndex uint16) (solana.PublicKey, error) {
+	return findAddress([]byte("insurance_fund_vault"), indexSeed(marketIndex))
+}
+
+func PerpMarketAddress(marketIndex uint16) (solana.PublicKey, error) {
+	return findAddress([]byte("perp_market"), indexSeed(marketIndex))
+}
@@ -0,0 +1,24 @@
+package drift
+
+import (
+	"github.com/FluxNFTLabs/sdk-go/client/svm/accounts"
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+)
+
+// RegisterAccounts registers the drift account types, programId is the deployed drift
+// program.
+func RegisterAccounts(r *accounts.Registry, programId solana.PublicKey) {
+	r.Register(programId, "OpenbookV2FulfillmentConfig", OpenbookV2FulfillmentConfigDiscriminator[:], func() bin.BinaryUnmarshaler { return new(OpenbookV2FulfillmentConfig) })
+	r.Register(programId, "PhoenixV1FulfillmentConfig", PhoenixV1FulfillmentConfigDiscriminator[:], func() bin.BinaryUnmarshaler { return new(PhoenixV1FulfillmentConfig) })
+	r.Register(programId, "SerumV3FulfillmentConfig", SerumV3FulfillmentConfigDiscriminator[:], func() bin.BinaryUnmarshaler { return new(SerumV3FulfillmentConfig) })
+	r.Register(programId, "InsuranceFundStake", InsuranceFundStakeDiscriminator[:], func() bin.BinaryUnmarshaler { return new(InsuranceFundStake) })
+	r.Register(programId, "ProtocolIfSharesTransferConfig", ProtocolIfSharesTransferConfigDiscriminator[:], func() bin.BinaryUnmarshaler { return new(ProtocolIfSharesTransferConfig) })
+	r.Register(programId, "PrelaunchOracle", PrelaunchOracleDiscriminator[:], func() bin.BinaryUnmarshaler { return new(PrelaunchOracle) })
+	r.Register(programId, "PerpMarket", PerpMarketDiscriminator[:], func() bin.BinaryUnmarshaler { return new(PerpMarket) })
+	r.Register(programId, "SpotMarket", SpotMarketDiscriminator[:], func() bin.BinaryUnmarshaler { return new(SpotMarket) })
+	r.Register(programId, "State", StateDiscriminator[:], func() bin.BinaryUnmarshaler { return new(State) })
+	r.Register(programId, "User", UserDiscriminator[:], func() bin.BinaryUnmarshaler { return new(User) })
+	r.Register(programId, "UserStats", UserStatsDiscriminator[:], func() bin.BinaryUnmarshaler { return new(UserStats) })
+	r.Register(programId, "ReferrerName", ReferrerNameDiscriminator[:], func() bin.BinaryUnmarshaler { return new(ReferrerName) })
+}
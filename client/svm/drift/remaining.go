@@ -0,0 +1,125 @@
+package drift
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// QuoteSpotMarketIndex is the spot market of the quote asset, in which perp pnl settles.
+const QuoteSpotMarketIndex = uint16(0)
+
+// remainingAccounts collects the markets an instruction loads and their oracles. Drift
+// reads them from the remaining accounts, oracles first, then spot and perp markets.
+type remainingAccounts struct {
+	client      *Client
+	oracles     []solana.PublicKey
+	spotMarkets map[uint16]*solana.AccountMeta
+	perpMarkets map[uint16]*solana.AccountMeta
+}
+
+func (c *Client) newRemainingAccounts() *remainingAccounts {
+	return &remainingAccounts{
+		client:      c,
+		spotMarkets: map[uint16]*solana.AccountMeta{},
+		perpMarkets: map[uint16]*solana.AccountMeta{},
+	}
+}
+
+func (r *remainingAccounts) addOracle(oracle solana.PublicKey) {
+	// the quote market has no oracle
+	if oracle.IsZero() {
+		return
+	}
+	for _, o := range r.oracles {
+		if o.Equals(oracle) {
+			return
+		}
+	}
+	r.oracles = append(r.oracles, oracle)
+}
+
+// addSpotMarket adds a spot market with its oracle, a market added as writable stays
+// writable.
+func (r *remainingAccounts) addSpotMarket(ctx context.Context, marketIndex uint16, writable bool) error {
+	if meta, ok := r.spotMarkets[marketIndex]; ok {
+		meta.IsWritable = meta.IsWritable || writable
+		return nil
+	}
+
+	market, err := r.client.SpotMarket(ctx, marketIndex)
+	if err != nil {
+		return err
+	}
+	addr, err := SpotMarketAddress(marketIndex)
+	if err != nil {
+		return err
+	}
+
+	r.spotMarkets[marketIndex] = &solana.AccountMeta{PublicKey: addr, IsWritable: writable}
+	r.addOracle(market.Oracle)
+	return nil
+}
+
+// addPerpMarket adds a perp market with its oracle and its quote spot market.
+func (r *remainingAccounts) addPerpMarket(ctx context.Context, marketIndex uint16, writable bool) error {
+	if meta, ok := r.perpMarkets[marketIndex]; ok {
+		meta.IsWritable = meta.IsWritable || writable
+		return nil
+	}
+
+	market, err := r.client.PerpMarket(ctx, marketIndex)
+	if err != nil {
+		return err
+	}
+	addr, err := PerpMarketAddress(marketIndex)
+	if err != nil {
+		return err
+	}
+
+	r.perpMarkets[marketIndex] = &solana.AccountMeta{PublicKey: addr, IsWritable: writable}
+	r.addOracle(market.Amm.Oracle)
+	return r.addSpotMarket(ctx, market.QuoteSpotMarketIndex, false)
+}
+
+// addUser adds the markets of the active positions of user, which margin checks load.
+func (r *remainingAccounts) addUser(ctx context.Context, user *User) error {
+	if user == nil {
+		return nil
+	}
+	for _, p := range user.ActiveSpotPositions() {
+		if err := r.addSpotMarket(ctx, p.MarketIndex, false); err != nil {
+			return err
+		}
+	}
+	for _, p := range user.ActivePerpPositions() {
+		if err := r.addPerpMarket(ctx, p.MarketIndex, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedMetas(markets map[uint16]*solana.AccountMeta) []*solana.AccountMeta {
+	indexes := make([]uint16, 0, len(markets))
+	for index := range markets {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	metas := make([]*solana.AccountMeta, 0, len(indexes))
+	for _, index := range indexes {
+		metas = append(metas, markets[index])
+	}
+	return metas
+}
+
+func (r *remainingAccounts) metas() []*solana.AccountMeta {
+	var metas []*solana.AccountMeta
+	for _, oracle := range r.oracles {
+		metas = append(metas, solana.Meta(oracle))
+	}
+	metas = append(metas, sortedMetas(r.spotMarkets)...)
+	return append(metas, sortedMetas(r.perpMarkets)...)
+}
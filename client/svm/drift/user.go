@@ -0,0 +1,101 @@
+package drift
+
+import (
+	"bytes"
+)
+
+// EncodeName encodes a user name, padded with spaces as drift does.
+func EncodeName(name string) [32]uint8 {
+	var encoded [32]uint8
+	copy(encoded[:], bytes.Repeat([]byte{' '}, len(encoded)))
+	copy(encoded[:], name)
+	return encoded
+}
+
+func DecodeName(name [32]uint8) string {
+	return string(bytes.TrimRight(name[:], " \x00"))
+}
+
+// IsAvailable reports whether the position slot is free, without balance nor open
+// orders.
+func (p SpotPosition) IsAvailable() bool {
+	return p.ScaledBalance == 0 && p.OpenOrders == 0
+}
+
+func (p PerpPosition) IsAvailable() bool {
+	return p.BaseAssetAmount == 0 && p.QuoteAssetAmount == 0 && p.OpenOrders == 0 && p.LpShares == 0
+}
+
+// ActiveSpotPositions returns the spot positions with a balance or open orders.
+func (u *User) ActiveSpotPositions() []SpotPosition {
+	var positions []SpotPosition
+	for _, p := range u.SpotPositions {
+		if !p.IsAvailable() {
+			positions = append(positions, p)
+		}
+	}
+	return positions
+}
+
+// ActivePerpPositions returns the perp positions with a base or quote amount, lp shares
+// or open orders.
+func (u *User) ActivePerpPositions() []PerpPosition {
+	var positions []PerpPosition
+	for _, p := range u.PerpPositions {
+		if !p.IsAvailable() {
+			positions = append(positions, p)
+		}
+	}
+	return positions
+}
+
+// SpotPosition returns the active spot position in market, nil if there is none.
+func (u *User) SpotPosition(marketIndex uint16) *SpotPosition {
+	for i, p := range u.SpotPositions {
+		if !p.IsAvailable() && p.MarketIndex == marketIndex {
+			return &u.SpotPositions[i]
+		}
+	}
+	return nil
+}
+
+// PerpPosition returns the active perp position in market, nil if there is none.
+func (u *User) PerpPosition(marketIndex uint16) *PerpPosition {
+	for i, p := range u.PerpPositions {
+		if !p.IsAvailable() && p.MarketIndex == marketIndex {
+			return &u.PerpPositions[i]
+		}
+	}
+	return nil
+}
+
+// GetOpenOrders returns the open orders, OpenOrders being their count.
+func (u *User) GetOpenOrders() []Order {
+	var orders []Order
+	for _, o := range u.Orders {
+		if o.Status == OrderStatusOpen {
+			orders = append(orders, o)
+		}
+	}
+	return orders
+}
+
+// GetOrder returns the open order with orderId, nil if there is none.
+func (u *User) GetOrder(orderId uint32) *Order {
+	for i, o := range u.Orders {
+		if o.Status == OrderStatusOpen && o.OrderId == orderId {
+			return &u.Orders[i]
+		}
+	}
+	return nil
+}
+
+// GetOrderByUserId returns the open order with userOrderId, nil if there is none.
+func (u *User) GetOrderByUserId(userOrderId uint8) *Order {
+	for i, o := range u.Orders {
+		if o.Status == OrderStatusOpen && o.UserOrderId == userOrderId {
+			return &u.Orders[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package drift
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	ag_binary "github.com/gagliardetto/binary"
+	ag_solanago "github.com/gagliardetto/solana-go"
+	ag_require "github.com/stretchr/testify/require"
+)
+
+// idlPath is the IDL the instructions are generated from, see go-idl-gen.sh.
+const idlPath = "../../../examples/chain/38_DeployDriftDEX/idl/drift.json"
+
+type idlInstruction struct {
+	Name     string `json:"name"`
+	Accounts []struct {
+		Name     string `json:"name"`
+		IsMut    bool   `json:"isMut"`
+		IsSigner bool   `json:"isSigner"`
+	} `json:"accounts"`
+}
+
+func exported(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// TestValidateAccounts checks for every IDL instruction that validation requires each
+// account in order and that the built instruction has the IDL account metas.
+func TestValidateAccounts(t *testing.T) {
+	raw, err := os.ReadFile(idlPath)
+	ag_require.NoError(t, err)
+	var idl struct {
+		Instructions []idlInstruction `json:"instructions"`
+	}
+	ag_require.NoError(t, json.Unmarshal(raw, &idl))
+	ag_require.Len(t, idl.Instructions, 166)
+
+	for _, ix := range idl.Instructions {
+		t.Run(exported(ix.Name), func(t *testing.T) {
+			// zero params decode for every instruction: no options, empty vecs, first enum variants
+			data := append(ag_binary.SighashInstruction(ix.Name), make([]byte, 1024)...)
+			decoded, err := DecodeInstruction(nil, data)
+			ag_require.NoError(t, err)
+			ag_require.Equal(t, exported(ix.Name), InstructionIDToName(decoded.TypeID))
+
+			inst := reflect.ValueOf(decoded.Impl)
+			ag_require.Equal(t, reflect.Ptr, inst.Kind())
+			inst.Elem().FieldByName("AccountMetaSlice").Set(reflect.ValueOf(make(ag_solanago.AccountMetaSlice, len(ix.Accounts))))
+			validator := inst.Interface().(interface{ Validate() error })
+
+			keys := make([]ag_solanago.PublicKey, len(ix.Accounts))
+			for i, acc := range ix.Accounts {
+				ag_require.EqualError(t, validator.Validate(), "accounts."+exported(acc.Name)+" is not set")
+
+				keys[i] = ag_solanago.NewWallet().PublicKey()
+				setter := inst.MethodByName("Set" + exported(acc.Name) + "Account")
+				ag_require.True(t, setter.IsValid(), "missing setter of account %s", acc.Name)
+				setter.Call([]reflect.Value{reflect.ValueOf(keys[i])})
+			}
+			ag_require.NoError(t, validator.Validate())
+
+			built := inst.MethodByName("Build").Call(nil)[0].Interface().(*Instruction)
+			metas := built.Accounts()
+			ag_require.Len(t, metas, len(ix.Accounts))
+			for i, acc := range ix.Accounts {
+				ag_require.Equal(t, keys[i], metas[i].PublicKey, acc.Name)
+				ag_require.Equal(t, acc.IsMut, metas[i].IsWritable, acc.Name)
+				ag_require.Equal(t, acc.IsSigner, metas[i].IsSigner, acc.Name)
+			}
+
+			builtData, err := built.Data()
+			ag_require.NoError(t, err)
+			redecoded, err := DecodeInstruction(metas, builtData)
+			ag_require.NoError(t, err)
+			ag_require.Equal(t, metas, redecoded.Accounts())
+			redata, err := redecoded.Data()
+			ag_require.NoError(t, err)
+			ag_require.Equal(t, builtData, redata)
+		})
+	}
+}
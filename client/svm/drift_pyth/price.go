@@ -0,0 +1,92 @@
+package pyth
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/FluxNFTLabs/sdk-go/client/svm/accounts"
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+)
+
+// PriceMagic starts the data of price accounts, which are not anchor accounts and have
+// no discriminator.
+const PriceMagic = uint32(0xa1b2c3d4)
+
+// PriceSize is the size of price accounts.
+const PriceSize = 3312
+
+type Ema struct {
+	Val   int64
+	Numer int64
+	Denom int64
+}
+
+// PriceInfo is a price with its status, enums are stored as 4 bytes.
+type PriceInfo struct {
+	Price   int64
+	Conf    uint64
+	Status  uint32
+	CorpAct uint32
+	PubSlot uint64
+}
+
+func (p PriceInfo) PriceStatus() PriceStatus {
+	return PriceStatus(p.Status)
+}
+
+type PriceComp struct {
+	Publisher solana.PublicKey
+	Agg       PriceInfo
+	Latest    PriceInfo
+}
+
+// Price is the C layout price account of the pyth program.
+type Price struct {
+	Magic     uint32
+	Ver       uint32
+	Atype     uint32
+	Size      uint32
+	Ptype     uint32
+	Expo      int32
+	Num       uint32
+	NumQt     uint32
+	LastSlot  uint64
+	ValidSlot uint64
+	Twap      Ema
+	Twac      Ema
+	Drv1      int64
+	Drv2      int64
+	Prod      solana.PublicKey
+	Next      solana.PublicKey
+	PrevSlot  uint64
+	PrevPrice int64
+	PrevConf  uint64
+	Drv3      int64
+	Agg       PriceInfo
+	Comp      [32]PriceComp
+}
+
+// price has the Price layout without its decoding method.
+type price Price
+
+func (obj *Price) UnmarshalWithDecoder(decoder *bin.Decoder) error {
+	if err := decoder.Decode((*price)(obj)); err != nil {
+		return err
+	}
+	if obj.Magic != PriceMagic {
+		return fmt.Errorf("invalid price magic %x", obj.Magic)
+	}
+	return nil
+}
+
+func (obj Price) PriceType() PriceType {
+	return PriceType(obj.Ptype)
+}
+
+// RegisterAccounts registers the price account type, programId is the deployed pyth
+// program.
+func RegisterAccounts(r *accounts.Registry, programId solana.PublicKey) {
+	magic := binary.LittleEndian.AppendUint32(nil, PriceMagic)
+	r.Register(programId, "Price", magic, func() bin.BinaryUnmarshaler { return new(Price) })
+}
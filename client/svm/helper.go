@@ -5,30 +5,31 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"strings"
 
-	sdkmath "cosmossdk.io/math"
 	"github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
 	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
 	chainclient "github.com/FluxNFTLabs/sdk-go/client/chain"
 	pyth "github.com/FluxNFTLabs/sdk-go/client/svm/drift_pyth"
+	"github.com/FluxNFTLabs/sdk-go/client/svm/preflight"
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
-	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
-	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	bin "github.com/gagliardetto/binary"
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/programs/system"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
 	MaxComputeBudget   = uint64(10_000_000)
 	ProgramAccountSize = 36
+	// BuildSignedTxTimeoutHeight is the timeout height of the txs built by BuildSignedTx
+	BuildSignedTxTimeoutHeight = uint64(19000000)
 )
 
 type WriteBuffer struct {
@@ -88,13 +89,21 @@ func (inst DeployWithMaxDataLen) MarshalWithEncoder(encoder *bin.Encoder) error
 	return encoder.WriteUint64(inst.DataLen, binary.LittleEndian)
 }
 
-// Find associated token account, panic when not found (not likely to happen)
-func MustFindAta(
+// FindAta finds the associated token account of wallet for mint
+func FindAta(
 	wallet, tokenProgram, mint, ataProgram solana.PublicKey,
-) solana.PublicKey {
+) (solana.PublicKey, error) {
 	ata, _, err := solana.FindProgramAddress([][]byte{
 		wallet[:], tokenProgram[:], mint[:],
 	}, ataProgram)
+	return ata, err
+}
+
+// Find associated token account, panic when not found (not likely to happen)
+func MustFindAta(
+	wallet, tokenProgram, mint, ataProgram solana.PublicKey,
+) solana.PublicKey {
+	ata, err := FindAta(wallet, tokenProgram, mint, ataProgram)
 	if err != nil {
 		panic(err)
 	}
@@ -102,6 +111,14 @@ func MustFindAta(
 	return ata
 }
 
+// IsAccountNotExisted reports whether err is the svm module error returned for missing
+// accounts.
+func IsAccountNotExisted(err error) bool {
+	return status.Code(err) == codes.NotFound ||
+		strings.Contains(err.Error(), svmtypes.ErrAccountNotExisted.Error())
+}
+
+// CreateInitAccountsMsg panics on errors, see NewInitAccountsMsg.
 func CreateInitAccountsMsg(
 	signerAddrs []sdk.AccAddress,
 	programSize int,
@@ -109,6 +126,21 @@ func CreateInitAccountsMsg(
 	programPubkey solana.PublicKey,
 	programBufferPubkey solana.PublicKey,
 ) *types.MsgTransaction {
+	msg, err := NewInitAccountsMsg(signerAddrs, programSize, ownerPubkey, programPubkey, programBufferPubkey)
+	if err != nil {
+		panic(err)
+	}
+	return msg
+}
+
+// NewInitAccountsMsg creates the program and buffer accounts of a program deployment.
+func NewInitAccountsMsg(
+	signerAddrs []sdk.AccAddress,
+	programSize int,
+	ownerPubkey solana.PublicKey,
+	programPubkey solana.PublicKey,
+	programBufferPubkey solana.PublicKey,
+) (*types.MsgTransaction, error) {
 	initTxBuilder := solana.NewTransactionBuilder()
 	createAccountIx := system.NewCreateAccountInstruction(
 		svmtypes.GetRentExemptLamportAmount(uint64(programSize)+48),
@@ -146,7 +178,7 @@ func CreateInitAccountsMsg(
 
 	initTx, err := initTxBuilder.Build()
 	if err != nil {
-		panic(initTx)
+		return nil, fmt.Errorf("solana tx build err: %w", err)
 	}
 
 	signers := []string{}
@@ -154,7 +186,7 @@ func CreateInitAccountsMsg(
 		signers = append(signers, acc.String())
 	}
 
-	return types.ToCosmosMsg(signers, MaxComputeBudget, initTx)
+	return types.NewMsgTransaction(signers, MaxComputeBudget, initTx)
 }
 
 func CreateProgramUploadMsgs(
@@ -214,7 +246,11 @@ func CreateProgramUploadMsgs(
 				return nil, fmt.Errorf("solana tx build err: %w", err)
 			}
 
-			res = append(res, types.ToCosmosMsg(signers, MaxComputeBudget, tx))
+			msg, err := types.NewMsgTransaction(signers, MaxComputeBudget, tx)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, msg)
 			txBuilder = solana.NewTransactionBuilder()
 		}
 	}
@@ -282,99 +318,33 @@ func CreateProgramUploadMsgs(
 	if err != nil {
 		return nil, err
 	}
-	res = append(res, types.ToCosmosMsg(signers, MaxComputeBudget, tx))
-	return res, nil
+	msg, err := types.NewMsgTransaction(signers, MaxComputeBudget, tx)
+	if err != nil {
+		return nil, err
+	}
+	return append(res, msg), nil
 }
 
+// BuildSignedTx signs msgs with the cosmos keys after the svm preflight checks, the gas
+// is estimated by simulation and priced with the default TxPipelineOptions. The tx
+// expires at BuildSignedTxTimeoutHeight.
 func BuildSignedTx(
 	chainClient chainclient.ChainClient,
 	msgs []sdk.Msg,
 	cosmosSignerKeys []*ethsecp256k1.PrivKey,
 ) (sdk.Tx, error) {
-	cosmosAccs := []sdk.AccAddress{}
-	cosmosPubkeys := []cryptotypes.PubKey{}
-	for _, signer := range cosmosSignerKeys {
-		cosmosPubkeys = append(cosmosPubkeys, signer.PubKey())
-		acc := sdk.AccAddress(signer.PubKey().Address().Bytes())
-		cosmosAccs = append(cosmosAccs, acc)
-	}
-	userNums := make([]uint64, len(cosmosSignerKeys))
-	userSeqs := make([]uint64, len(cosmosSignerKeys))
-	clientCtx := chainClient.ClientContext()
-
-	for i, userAddr := range cosmosAccs {
-		userNum, userSeq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, userAddr)
-		if err != nil {
-			return nil, fmt.Errorf("get acc number err: %w", err)
-		}
-
-		userNums[i] = userNum
-		userSeqs[i] = userSeq
-	}
-
-	txBuilder := clientCtx.TxConfig.NewTxBuilder()
-	timeoutHeight := uint64(19000000)
-	signatures := make([]signingtypes.SignatureV2, len(cosmosSignerKeys))
-
-	for i := range cosmosPubkeys {
-		userSigV2 := signingtypes.SignatureV2{
-			PubKey: cosmosPubkeys[i],
-			Data: &signingtypes.SingleSignatureData{
-				SignMode:  signingtypes.SignMode_SIGN_MODE_DIRECT,
-				Signature: nil,
-			},
-			Sequence: userSeqs[i],
-		}
-		signatures[i] = userSigV2
-	}
-
-	// build tx
-	txBuilder.SetMsgs(msgs...)
-	txBuilder.SetTimeoutHeight(timeoutHeight)
-	txBuilder.SetSignatures(signatures...)
-
-	// simulate to estimate gas
-	bz, err := chainClient.ClientContext().TxConfig.TxEncoder()(txBuilder.GetTx())
-	if err != nil {
-		return nil, fmt.Errorf("encode tx err: %w", err)
+	signers := make([]TxSigner, 0, len(cosmosSignerKeys))
+	for _, key := range cosmosSignerKeys {
+		signers = append(signers, key)
 	}
 
-	simulateRes, err := chainClient.SimulateSignedTx(bz)
-	if err != nil {
-		return nil, fmt.Errorf("encode tx err: %w", err)
-	}
-
-	gasPrice := sdkmath.NewIntFromUint64(500000000)
-	estimateGas := simulateRes.GasInfo.GasUsed * 2
-	fee := sdkmath.NewIntFromUint64(estimateGas).Mul(gasPrice)
-	txBuilder.SetGasLimit(estimateGas)
-	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("lux", fee)))
-
-	// build and sign tx
-	for i, pk := range cosmosSignerKeys {
-		sig, err := tx.SignWithPrivKey(
-			context.Background(),
-			signingtypes.SignMode_SIGN_MODE_DIRECT,
-			authsigning.SignerData{
-				Address:       cosmosAccs[i].String(),
-				ChainID:       clientCtx.ChainID,
-				AccountNumber: userNums[i],
-				Sequence:      userSeqs[i],
-				PubKey:        pk.PubKey(),
-			},
-			txBuilder, pk, clientCtx.TxConfig, userSeqs[i],
-		)
-		if err != nil {
-			panic(err)
-		}
-
-		signatures[i] = sig
+	opts := DefaultTxPipelineOptions()
+	opts.TimeoutHeight = BuildSignedTxTimeoutHeight
+	p := &TxPipeline{client: chainClient, opts: opts}
+	if grpcClient := chainClient.ClientContext().GRPCClient; grpcClient != nil {
+		p.checker = preflight.NewChecker(preflight.NewQueryLinks(svmtypes.NewQueryClient(grpcClient)))
 	}
-
-	// set signatures again
-	txBuilder.SetSignatures(signatures...)
-	// build sign data
-	return txBuilder.GetTx(), nil
+	return p.buildSignedTx(context.Background(), msgs, signers, nil)
 }
 
 func GetOrLinkSvmAccount(
@@ -448,7 +418,7 @@ func InitializePythOracle(
 
 	oracleSvmPubkey, _, err = GetOrLinkSvmAccount(chainClient, clientCtx, oracleCosmosPrivKey, oracleSvmPrivKey, 0)
 	if err != nil {
-		panic(err)
+		return solana.PublicKey{}, err
 	}
 
 	oracleSize := uint64(3312) // deduce from Price struct
@@ -469,13 +439,16 @@ func InitializePythOracle(
 		AddInstruction(initializeOracleIx).
 		Build()
 	if err != nil {
-		panic(err)
+		return solana.PublicKey{}, fmt.Errorf("solana tx build err: %w", err)
 	}
 
-	initOracleMsg := types.ToCosmosMsg([]string{
+	initOracleMsg, err := types.NewMsgTransaction([]string{
 		chainClient.FromAddress().String(),
 		oracleCosmosAddr.String(),
 	}, 1000_000, initOracleTx)
+	if err != nil {
+		return solana.PublicKey{}, err
+	}
 
 	oracleSignedTx, err := BuildSignedTx(
 		chainClient, []sdk.Msg{initOracleMsg},
@@ -0,0 +1,136 @@
+package svm
+
+import (
+	"encoding/binary"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+)
+
+// Upgradeable loader account layouts, the buffer data follows the buffer metadata and
+// the program data follows the program data metadata.
+const (
+	BufferMetadataSize      = 37
+	ProgramDataMetadataSize = 45
+)
+
+type InitializeBuffer struct{}
+
+func (inst InitializeBuffer) MarshalWithEncoder(encoder *bin.Encoder) error {
+	return encoder.WriteUint32(0, binary.LittleEndian)
+}
+
+type Upgrade struct{}
+
+func (inst Upgrade) MarshalWithEncoder(encoder *bin.Encoder) error {
+	return encoder.WriteUint32(3, binary.LittleEndian)
+}
+
+type SetAuthority struct{}
+
+func (inst SetAuthority) MarshalWithEncoder(encoder *bin.Encoder) error {
+	return encoder.WriteUint32(4, binary.LittleEndian)
+}
+
+type Close struct{}
+
+func (inst Close) MarshalWithEncoder(encoder *bin.Encoder) error {
+	return encoder.WriteUint32(5, binary.LittleEndian)
+}
+
+// ProgramDataAddress returns the program data account of an upgradeable program.
+func ProgramDataAddress(program solana.PublicKey) (solana.PublicKey, error) {
+	programData, _, err := solana.FindProgramAddress([][]byte{program[:]}, solana.BPFLoaderUpgradeableProgramID)
+	return programData, err
+}
+
+func NewInitializeBufferInstruction(buffer, authority solana.PublicKey) *solana.GenericInstruction {
+	return solana.NewInstruction(
+		solana.BPFLoaderUpgradeableProgramID,
+		solana.AccountMetaSlice{
+			{PublicKey: buffer, IsWritable: true, IsSigner: true},
+			{PublicKey: authority, IsWritable: true, IsSigner: true},
+		},
+		MustMarshalIxData(InitializeBuffer{}),
+	)
+}
+
+func NewWriteBufferInstruction(buffer, authority solana.PublicKey, offset uint32, data []byte) *solana.GenericInstruction {
+	return solana.NewInstruction(
+		solana.BPFLoaderUpgradeableProgramID,
+		solana.AccountMetaSlice{
+			{PublicKey: buffer, IsWritable: true, IsSigner: false},
+			{PublicKey: authority, IsWritable: true, IsSigner: true},
+		},
+		MustMarshalIxData(WriteBuffer{Offset: offset, Data: data}),
+	)
+}
+
+func NewDeployWithMaxDataLenInstruction(
+	payer, programData, program, buffer, authority solana.PublicKey,
+	maxDataLen uint64,
+) *solana.GenericInstruction {
+	return solana.NewInstruction(
+		solana.BPFLoaderUpgradeableProgramID,
+		solana.AccountMetaSlice{
+			{PublicKey: payer, IsWritable: true, IsSigner: true},
+			{PublicKey: programData, IsWritable: true, IsSigner: false},
+			{PublicKey: program, IsWritable: true, IsSigner: false},
+			{PublicKey: buffer, IsWritable: true, IsSigner: false},
+			{PublicKey: solana.SysVarRentPubkey, IsWritable: false, IsSigner: false},
+			{PublicKey: solana.SysVarClockPubkey, IsWritable: false, IsSigner: false},
+			{PublicKey: solana.SystemProgramID, IsWritable: false, IsSigner: false},
+			{PublicKey: authority, IsWritable: true, IsSigner: true},
+		},
+		MustMarshalIxData(DeployWithMaxDataLen{DataLen: maxDataLen}),
+	)
+}
+
+// NewUpgradeInstruction replaces the program code with the buffer content, the buffer
+// lamports are sent to spill.
+func NewUpgradeInstruction(programData, program, buffer, spill, authority solana.PublicKey) *solana.GenericInstruction {
+	return solana.NewInstruction(
+		solana.BPFLoaderUpgradeableProgramID,
+		solana.AccountMetaSlice{
+			{PublicKey: programData, IsWritable: true, IsSigner: false},
+			{PublicKey: program, IsWritable: true, IsSigner: false},
+			{PublicKey: buffer, IsWritable: true, IsSigner: false},
+			{PublicKey: spill, IsWritable: true, IsSigner: false},
+			{PublicKey: solana.SysVarRentPubkey, IsWritable: false, IsSigner: false},
+			{PublicKey: solana.SysVarClockPubkey, IsWritable: false, IsSigner: false},
+			{PublicKey: authority, IsWritable: false, IsSigner: true},
+		},
+		MustMarshalIxData(Upgrade{}),
+	)
+}
+
+// NewSetAuthorityInstruction sets the authority of a buffer or program data account,
+// a nil newAuthority makes the program immutable.
+func NewSetAuthorityInstruction(account, authority solana.PublicKey, newAuthority *solana.PublicKey) *solana.GenericInstruction {
+	accounts := solana.AccountMetaSlice{
+		{PublicKey: account, IsWritable: true, IsSigner: false},
+		{PublicKey: authority, IsWritable: false, IsSigner: true},
+	}
+	if newAuthority != nil {
+		accounts = append(accounts, &solana.AccountMeta{PublicKey: *newAuthority, IsWritable: false, IsSigner: false})
+	}
+
+	return solana.NewInstruction(
+		solana.BPFLoaderUpgradeableProgramID,
+		accounts,
+		MustMarshalIxData(SetAuthority{}),
+	)
+}
+
+// NewCloseBufferInstruction closes a buffer account, sending its lamports to recipient.
+func NewCloseBufferInstruction(buffer, recipient, authority solana.PublicKey) *solana.GenericInstruction {
+	return solana.NewInstruction(
+		solana.BPFLoaderUpgradeableProgramID,
+		solana.AccountMetaSlice{
+			{PublicKey: buffer, IsWritable: true, IsSigner: false},
+			{PublicKey: recipient, IsWritable: true, IsSigner: false},
+			{PublicKey: authority, IsWritable: false, IsSigner: true},
+		},
+		MustMarshalIxData(Close{}),
+	)
+}
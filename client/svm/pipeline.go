@@ -0,0 +1,400 @@
+package svm
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	"github.com/FluxNFTLabs/sdk-go/client/svm/preflight"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/gagliardetto/solana-go"
+)
+
+// TxSigner signs cosmos txs in direct mode, cosmos private keys such as
+// *ethsecp256k1.PrivKey are signers.
+type TxSigner interface {
+	PubKey() cryptotypes.PubKey
+	Sign(msg []byte) ([]byte, error)
+}
+
+// KeyringSigner signs with a keyring key.
+type KeyringSigner struct {
+	kr     keyring.Keyring
+	name   string
+	pubkey cryptotypes.PubKey
+}
+
+func NewKeyringSigner(kr keyring.Keyring, name string) (*KeyringSigner, error) {
+	record, err := kr.Key(name)
+	if err != nil {
+		return nil, fmt.Errorf("get key %s err: %w", name, err)
+	}
+
+	pubkey, err := record.GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("get pubkey of %s err: %w", name, err)
+	}
+
+	return &KeyringSigner{kr: kr, name: name, pubkey: pubkey}, nil
+}
+
+// ClientSigner returns the signer of the client from key.
+func ClientSigner(c PipelineClient) (*KeyringSigner, error) {
+	clientCtx := c.ClientContext()
+	return NewKeyringSigner(clientCtx.Keyring, clientCtx.GetFromName())
+}
+
+func (s *KeyringSigner) PubKey() cryptotypes.PubKey {
+	return s.pubkey
+}
+
+func (s *KeyringSigner) Sign(msg []byte) ([]byte, error) {
+	sig, _, err := s.kr.Sign(s.name, msg, signingtypes.SignMode_SIGN_MODE_DIRECT)
+	return sig, err
+}
+
+// PipelineClient is the part of chain.ChainClient used by TxPipeline.
+type PipelineClient interface {
+	ClientContext() client.Context
+	SimulateSignedTx(txBytes []byte) (*txtypes.SimulateResponse, error)
+	SyncBroadcastSignedTx(txBytes []byte) (*txtypes.BroadcastTxResponse, error)
+}
+
+type TxPipelineOptions struct {
+	// GasPrice is the price per gas unit in FeeDenom
+	GasPrice sdkmath.Int
+	FeeDenom string
+	// GasMultiplier scales the simulated gas into the gas limit
+	GasMultiplier float64
+	// ComputeBudgetMultiplier scales the simulated compute units into the compute budget
+	ComputeBudgetMultiplier float64
+	// MaxComputeBudget is the budget txs are simulated with, and the budget cap
+	MaxComputeBudget uint64
+	// TimeoutHeight is the height txs expire at, 0 for never
+	TimeoutHeight uint64
+}
+
+type TxPipelineOption func(opts *TxPipelineOptions) error
+
+func DefaultTxPipelineOptions() *TxPipelineOptions {
+	return &TxPipelineOptions{
+		GasPrice:                sdkmath.NewIntFromUint64(500_000_000),
+		FeeDenom:                "lux",
+		GasMultiplier:           2,
+		ComputeBudgetMultiplier: 2,
+		MaxComputeBudget:        MaxComputeBudget,
+	}
+}
+
+func OptionGasPrice(price sdk.Coin) TxPipelineOption {
+	return func(opts *TxPipelineOptions) error {
+		if err := price.Validate(); err != nil {
+			return err
+		}
+		opts.GasPrice = price.Amount
+		opts.FeeDenom = price.Denom
+		return nil
+	}
+}
+
+func OptionGasMultiplier(multiplier float64) TxPipelineOption {
+	return func(opts *TxPipelineOptions) error {
+		if multiplier < 1 {
+			return fmt.Errorf("gas multiplier must be at least 1")
+		}
+		opts.GasMultiplier = multiplier
+		return nil
+	}
+}
+
+func OptionComputeBudgetMultiplier(multiplier float64) TxPipelineOption {
+	return func(opts *TxPipelineOptions) error {
+		if multiplier < 1 {
+			return fmt.Errorf("compute budget multiplier must be at least 1")
+		}
+		opts.ComputeBudgetMultiplier = multiplier
+		return nil
+	}
+}
+
+func OptionMaxComputeBudget(budget uint64) TxPipelineOption {
+	return func(opts *TxPipelineOptions) error {
+		if budget == 0 {
+			return fmt.Errorf("max compute budget must be positive")
+		}
+		opts.MaxComputeBudget = budget
+		return nil
+	}
+}
+
+func OptionTimeoutHeight(height uint64) TxPipelineOption {
+	return func(opts *TxPipelineOptions) error {
+		opts.TimeoutHeight = height
+		return nil
+	}
+}
+
+// TxPipeline builds, signs and broadcasts svm transactions. The cosmos signers of a
+// transaction are the accounts linked to its svm signers, each signed for by one of
+// the given TxSigners. Txs are checked against the chain link rules before simulation.
+type TxPipeline struct {
+	client      PipelineClient
+	queryClient svmtypes.QueryClient
+	checker     *preflight.Checker
+	opts        *TxPipelineOptions
+}
+
+func NewTxPipeline(c PipelineClient, options ...TxPipelineOption) (*TxPipeline, error) {
+	opts := DefaultTxPipelineOptions()
+	for _, o := range options {
+		if err := o(opts); err != nil {
+			return nil, err
+		}
+	}
+
+	grpcClient := c.ClientContext().GRPCClient
+	if grpcClient == nil {
+		return nil, fmt.Errorf("client context has no grpc client")
+	}
+
+	queryClient := svmtypes.NewQueryClient(grpcClient)
+	return &TxPipeline{
+		client:      c,
+		queryClient: queryClient,
+		checker:     preflight.NewChecker(preflight.NewQueryLinks(queryClient)),
+		opts:        opts,
+	}, nil
+}
+
+// QueryClient returns the svm query client of the pipeline.
+func (p *TxPipeline) QueryClient() svmtypes.QueryClient {
+	return p.queryClient
+}
+
+// ResolveSigners returns the cosmos accounts linked to the svm signers of tx, in
+// account order, the fee payer first.
+func (p *TxPipeline) ResolveSigners(ctx context.Context, tx *solana.Transaction) ([]sdk.AccAddress, error) {
+	var signers []sdk.AccAddress
+	for _, pubkey := range tx.Message.AccountKeys {
+		if !tx.Message.IsSigner(pubkey) {
+			continue
+		}
+
+		res, err := p.queryClient.AccountLinkBySvmAddr(ctx, &svmtypes.AccountLinkRequest{Address: pubkey.String()})
+		if err != nil {
+			return nil, fmt.Errorf("get account link of svm signer %s err: %w", pubkey, err)
+		}
+		if res.Link == nil {
+			return nil, fmt.Errorf("svm signer %s is not linked to a cosmos account", pubkey)
+		}
+		signers = append(signers, res.Link.CosmosAddr)
+	}
+	if len(signers) == 0 {
+		return nil, fmt.Errorf("svm tx has no signer")
+	}
+	return signers, nil
+}
+
+// BuildMsg converts tx to a MsgTransaction with the max compute budget.
+func (p *TxPipeline) BuildMsg(ctx context.Context, tx *solana.Transaction) (*svmtypes.MsgTransaction, error) {
+	signers, err := p.ResolveSigners(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	signerAddrs := make([]string, 0, len(signers))
+	for _, signer := range signers {
+		signerAddrs = append(signerAddrs, signer.String())
+	}
+	return svmtypes.NewMsgTransaction(signerAddrs, p.opts.MaxComputeBudget, tx)
+}
+
+// BuildTx builds the signed cosmos tx of tx, its compute budget and gas estimated by
+// simulation.
+func (p *TxPipeline) BuildTx(ctx context.Context, tx *solana.Transaction, signers ...TxSigner) (sdk.Tx, error) {
+	msg, err := p.BuildMsg(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.buildSignedTx(ctx, []sdk.Msg{msg}, signers, func(simRes *txtypes.SimulateResponse) error {
+		if simRes.Result == nil || len(simRes.Result.MsgResponses) == 0 {
+			return fmt.Errorf("simulation returned no msg response")
+		}
+
+		var msgRes svmtypes.MsgTransactionResponse
+		if err := proto.Unmarshal(simRes.Result.MsgResponses[0].Value, &msgRes); err != nil {
+			return fmt.Errorf("unmarshal svm msg response err: %w", err)
+		}
+		msg.ComputeBudget = min(scale(msgRes.UnitConsumed, p.opts.ComputeBudgetMultiplier), p.opts.MaxComputeBudget)
+		return nil
+	})
+}
+
+// Send builds tx, then broadcasts it and waits for its inclusion.
+func (p *TxPipeline) Send(ctx context.Context, tx *solana.Transaction, signers ...TxSigner) (*txtypes.BroadcastTxResponse, error) {
+	signedTx, err := p.BuildTx(ctx, tx, signers...)
+	if err != nil {
+		return nil, err
+	}
+
+	txBytes, err := p.client.ClientContext().TxConfig.TxEncoder()(signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("encode tx err: %w", err)
+	}
+	return p.client.SyncBroadcastSignedTx(txBytes)
+}
+
+// SendInstructions sends the instructions in a single tx, the fee payer is the first
+// signer of the first instruction.
+func (p *TxPipeline) SendInstructions(ctx context.Context, ixs []solana.Instruction, signers ...TxSigner) (*txtypes.BroadcastTxResponse, error) {
+	builder := solana.NewTransactionBuilder()
+	for _, ix := range ixs {
+		builder.AddInstruction(ix)
+	}
+
+	tx, err := builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("solana tx build err: %w", err)
+	}
+	return p.Send(ctx, tx, signers...)
+}
+
+// buildSignedTx simulates msgs unsigned, lets adjust update the msgs from the
+// simulation, then sets gas and fee and signs. The signers must cover the signers of
+// msgs.
+func (p *TxPipeline) buildSignedTx(
+	ctx context.Context,
+	msgs []sdk.Msg,
+	signers []TxSigner,
+	adjust func(simRes *txtypes.SimulateResponse) error,
+) (sdk.Tx, error) {
+	clientCtx := p.client.ClientContext()
+	if p.checker != nil {
+		if err := p.checker.CheckMsgs(ctx, msgs); err != nil {
+			return nil, err
+		}
+	}
+
+	// signer order of the tx, unique signers of msgs in order
+	var (
+		accs []sdk.AccAddress
+		seen = map[string]struct{}{}
+	)
+	for _, msg := range msgs {
+		legacyMsg, ok := msg.(sdk.LegacyMsg)
+		if !ok {
+			return nil, fmt.Errorf("%s has no signers", sdk.MsgTypeURL(msg))
+		}
+		for _, signer := range legacyMsg.GetSigners() {
+			if _, ok := seen[string(signer)]; ok {
+				continue
+			}
+			seen[string(signer)] = struct{}{}
+			accs = append(accs, signer)
+		}
+	}
+
+	signerByAddr := make(map[string]TxSigner, len(signers))
+	for _, s := range signers {
+		signerByAddr[sdk.AccAddress(s.PubKey().Address()).String()] = s
+	}
+
+	txSigners := make([]TxSigner, len(accs))
+	signerData := make([]authsigning.SignerData, len(accs))
+	signatures := make([]signingtypes.SignatureV2, len(accs))
+	for i, acc := range accs {
+		s, ok := signerByAddr[acc.String()]
+		if !ok {
+			return nil, fmt.Errorf("no signer for %s", acc)
+		}
+
+		num, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, acc)
+		if err != nil {
+			return nil, fmt.Errorf("get account number of %s err: %w", acc, err)
+		}
+
+		txSigners[i] = s
+		signerData[i] = authsigning.SignerData{
+			Address:       acc.String(),
+			ChainID:       clientCtx.ChainID,
+			AccountNumber: num,
+			Sequence:      seq,
+			PubKey:        s.PubKey(),
+		}
+		signatures[i] = signingtypes.SignatureV2{
+			PubKey: s.PubKey(),
+			Data: &signingtypes.SingleSignatureData{
+				SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT,
+			},
+			Sequence: seq,
+		}
+	}
+
+	txBuilder := clientCtx.TxConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+	txBuilder.SetTimeoutHeight(p.opts.TimeoutHeight)
+	if err := txBuilder.SetSignatures(signatures...); err != nil {
+		return nil, err
+	}
+
+	// simulate to estimate gas
+	bz, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("encode tx err: %w", err)
+	}
+
+	simRes, err := p.client.SimulateSignedTx(bz)
+	if err != nil {
+		return nil, fmt.Errorf("simulate tx err: %w", err)
+	}
+
+	if adjust != nil {
+		if err := adjust(simRes); err != nil {
+			return nil, err
+		}
+		if err := txBuilder.SetMsgs(msgs...); err != nil {
+			return nil, err
+		}
+	}
+
+	gas := scale(simRes.GasInfo.GasUsed, p.opts.GasMultiplier)
+	txBuilder.SetGasLimit(gas)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(p.opts.FeeDenom, sdkmath.NewIntFromUint64(gas).Mul(p.opts.GasPrice))))
+
+	for i, s := range txSigners {
+		signBytes, err := authsigning.GetSignBytesAdapter(ctx, clientCtx.TxConfig.SignModeHandler(), signingtypes.SignMode_SIGN_MODE_DIRECT, signerData[i], txBuilder.GetTx())
+		if err != nil {
+			return nil, fmt.Errorf("get sign bytes err: %w", err)
+		}
+
+		sig, err := s.Sign(signBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%s sign err: %w", signerData[i].Address, err)
+		}
+		signatures[i].Data = &signingtypes.SingleSignatureData{
+			SignMode:  signingtypes.SignMode_SIGN_MODE_DIRECT,
+			Signature: sig,
+		}
+	}
+
+	if err := txBuilder.SetSignatures(signatures...); err != nil {
+		return nil, err
+	}
+	return txBuilder.GetTx(), nil
+}
+
+func scale(x uint64, multiplier float64) uint64 {
+	return uint64(math.Ceil(float64(x) * multiplier))
+}
@@ -0,0 +1,196 @@
+// Package preflight checks svm txs against the account link rules of the chain
+// SvmDecorator before they are broadcast, so they fail without paying fees:
+//
+//   - a MsgTransaction must be the only msg of its tx
+//   - every msg signer must be linked to a svm account
+//   - every svm signer account must be linked to one of the tx signers
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gagliardetto/solana-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LinkResolver looks account links up, returning nil for unlinked accounts.
+type LinkResolver interface {
+	LinkByCosmosAddr(ctx context.Context, addr sdk.AccAddress) (*svmtypes.AccountLink, error)
+	LinkBySvmAddr(ctx context.Context, pubkey solana.PublicKey) (*svmtypes.AccountLink, error)
+}
+
+// QueryLinks resolves the links live from the chain.
+type QueryLinks struct {
+	queryClient svmtypes.QueryClient
+}
+
+func NewQueryLinks(queryClient svmtypes.QueryClient) *QueryLinks {
+	return &QueryLinks{queryClient: queryClient}
+}
+
+func (l *QueryLinks) LinkByCosmosAddr(ctx context.Context, addr sdk.AccAddress) (*svmtypes.AccountLink, error) {
+	res, err := l.queryClient.AccountLink(ctx, &svmtypes.AccountLinkRequest{Address: addr.String()})
+	return linkOrNil(res, err)
+}
+
+func (l *QueryLinks) LinkBySvmAddr(ctx context.Context, pubkey solana.PublicKey) (*svmtypes.AccountLink, error) {
+	res, err := l.queryClient.AccountLinkBySvmAddr(ctx, &svmtypes.AccountLinkRequest{Address: pubkey.String()})
+	return linkOrNil(res, err)
+}
+
+func linkOrNil(res *svmtypes.AccountLinkResponse, err error) (*svmtypes.AccountLink, error) {
+	if err != nil {
+		if status.Code(err) == codes.NotFound || strings.Contains(err.Error(), "account link not found") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return res.Link, nil
+}
+
+// LocalLinks resolves the links from a map of cosmos bech32 addresses to their linked
+// svm accounts, for checks without a node.
+type LocalLinks map[string]solana.PublicKey
+
+func (l LocalLinks) LinkByCosmosAddr(_ context.Context, addr sdk.AccAddress) (*svmtypes.AccountLink, error) {
+	pubkey, ok := l[addr.String()]
+	if !ok {
+		return nil, nil
+	}
+	return &svmtypes.AccountLink{CosmosAddr: addr, SvmAddr: pubkey[:]}, nil
+}
+
+func (l LocalLinks) LinkBySvmAddr(_ context.Context, pubkey solana.PublicKey) (*svmtypes.AccountLink, error) {
+	for addr, linked := range l {
+		if !linked.Equals(pubkey) {
+			continue
+		}
+
+		cosmosAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid linked address %s: %w", addr, err)
+		}
+		return &svmtypes.AccountLink{CosmosAddr: cosmosAddr, SvmAddr: pubkey[:]}, nil
+	}
+	return nil, nil
+}
+
+// Error is a rule violation. Indexes not relevant to the violation are -1.
+type Error struct {
+	// Signer indexes the msg signers
+	Signer int
+	// Instruction and Account index the msg instructions and the instruction accounts
+	Instruction int
+	Account     int
+	// Address is the offending cosmos or svm address
+	Address string
+	Reason  string
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	b.WriteString("svm preflight: ")
+	if e.Signer >= 0 {
+		fmt.Fprintf(&b, "signer %d ", e.Signer)
+	}
+	if e.Instruction >= 0 {
+		fmt.Fprintf(&b, "instruction %d ", e.Instruction)
+	}
+	if e.Account >= 0 {
+		fmt.Fprintf(&b, "account %d ", e.Account)
+	}
+	if e.Address != "" {
+		fmt.Fprintf(&b, "%s ", e.Address)
+	}
+	b.WriteString(e.Reason)
+	return b.String()
+}
+
+// Checker applies the link rules.
+type Checker struct {
+	links LinkResolver
+}
+
+func NewChecker(links LinkResolver) *Checker {
+	return &Checker{links: links}
+}
+
+// CheckMsgs checks the msgs of a tx, txs without MsgTransaction always pass.
+func (c *Checker) CheckMsgs(ctx context.Context, msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		svmMsg, ok := msg.(*svmtypes.MsgTransaction)
+		if !ok {
+			continue
+		}
+		if len(msgs) > 1 {
+			return &Error{
+				Signer:      -1,
+				Instruction: -1,
+				Account:     -1,
+				Reason:      fmt.Sprintf("msg %d: svm transaction must have only one MsgTransaction, tx has %d msgs", i, len(msgs)),
+			}
+		}
+		return c.CheckMsg(ctx, svmMsg)
+	}
+	return nil
+}
+
+// CheckMsg checks a MsgTransaction sent alone, its signers being the tx signers.
+func (c *Checker) CheckMsg(ctx context.Context, msg *svmtypes.MsgTransaction) error {
+	signers := make(map[string]bool, len(msg.Signers))
+	for i, signer := range msg.Signers {
+		addr, err := sdk.AccAddressFromBech32(signer)
+		if err != nil {
+			return &Error{Signer: i, Instruction: -1, Account: -1, Address: signer, Reason: "is not a valid bech32 address"}
+		}
+
+		link, err := c.links.LinkByCosmosAddr(ctx, addr)
+		if err != nil {
+			return fmt.Errorf("get account link of %s err: %w", signer, err)
+		}
+		if link == nil {
+			return &Error{Signer: i, Instruction: -1, Account: -1, Address: signer, Reason: "is not linked to any svm pubkey"}
+		}
+		signers[string(addr)] = true
+	}
+
+	for i, ix := range msg.Instructions {
+		for j, ixAcc := range ix.Accounts {
+			if !ixAcc.IsSigner {
+				continue
+			}
+			if int(ixAcc.CallerIndex) >= len(msg.Accounts) {
+				return &Error{Signer: -1, Instruction: i, Account: j, Reason: fmt.Sprintf("caller index %d out of %d accounts", ixAcc.CallerIndex, len(msg.Accounts))}
+			}
+
+			svmAddr := msg.Accounts[ixAcc.CallerIndex]
+			pubkey, err := solana.PublicKeyFromBase58(svmAddr)
+			if err != nil {
+				return &Error{Signer: -1, Instruction: i, Account: j, Address: svmAddr, Reason: "is not a valid svm pubkey"}
+			}
+
+			link, err := c.links.LinkBySvmAddr(ctx, pubkey)
+			if err != nil {
+				return fmt.Errorf("get account link of %s err: %w", svmAddr, err)
+			}
+			if link == nil {
+				return &Error{Signer: -1, Instruction: i, Account: j, Address: svmAddr, Reason: "is not linked to any cosmos addr"}
+			}
+			if !signers[string(link.CosmosAddr)] {
+				return &Error{
+					Signer:      -1,
+					Instruction: i,
+					Account:     j,
+					Address:     svmAddr,
+					Reason:      fmt.Sprintf("linked cosmos addr %s is not a tx signer", link.CosmosAddr),
+				}
+			}
+		}
+	}
+	return nil
+}
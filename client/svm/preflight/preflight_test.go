@@ -0,0 +1,164 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alice     = sdk.AccAddress([]byte("alice_______________"))
+	bob       = sdk.AccAddress([]byte("bob_________________"))
+	alicePk   = solana.NewWallet().PublicKey()
+	bobPk     = solana.NewWallet().PublicKey()
+	stranger  = solana.NewWallet().PublicKey()
+	programId = solana.NewWallet().PublicKey()
+)
+
+func testLinks() LocalLinks {
+	return LocalLinks{
+		alice.String(): alicePk,
+		bob.String():   bobPk,
+	}
+}
+
+// testMsg builds a msg paid by alice, whose instruction also requires the svm signers.
+func testMsg(t *testing.T, signers []sdk.AccAddress, svmSigners ...solana.PublicKey) *svmtypes.MsgTransaction {
+	accounts := solana.AccountMetaSlice{solana.Meta(alicePk).SIGNER().WRITE()}
+	for _, pk := range svmSigners {
+		accounts = append(accounts, solana.Meta(pk).SIGNER())
+	}
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{solana.NewInstruction(programId, accounts, []byte{1})},
+		solana.Hash{},
+		solana.TransactionPayer(alicePk),
+	)
+	require.NoError(t, err)
+
+	signerAddrs := make([]string, 0, len(signers))
+	for _, s := range signers {
+		signerAddrs = append(signerAddrs, s.String())
+	}
+	msg, err := svmtypes.NewMsgTransaction(signerAddrs, 1_000_000, tx)
+	require.NoError(t, err)
+	return msg
+}
+
+func requirePreflightError(t *testing.T, err error, expected *Error) {
+	t.Helper()
+	var preflightErr *Error
+	require.True(t, errors.As(err, &preflightErr), "expected a preflight error, got %v", err)
+	require.Equal(t, expected, preflightErr)
+}
+
+func TestCheckMsgLocalLinks(t *testing.T) {
+	checker := NewChecker(testLinks())
+	ctx := context.Background()
+
+	require.NoError(t, checker.CheckMsg(ctx, testMsg(t, []sdk.AccAddress{alice})))
+	require.NoError(t, checker.CheckMsg(ctx, testMsg(t, []sdk.AccAddress{alice, bob}, bobPk)))
+
+	t.Run("unlinked svm signer", func(t *testing.T) {
+		err := checker.CheckMsg(ctx, testMsg(t, []sdk.AccAddress{alice}, stranger))
+		requirePreflightError(t, err, &Error{
+			Signer:      -1,
+			Instruction: 0,
+			Account:     1,
+			Address:     stranger.String(),
+			Reason:      "is not linked to any cosmos addr",
+		})
+	})
+
+	t.Run("svm signer linked to a non signer", func(t *testing.T) {
+		err := checker.CheckMsg(ctx, testMsg(t, []sdk.AccAddress{alice}, bobPk))
+		requirePreflightError(t, err, &Error{
+			Signer:      -1,
+			Instruction: 0,
+			Account:     1,
+			Address:     bobPk.String(),
+			Reason:      "linked cosmos addr " + bob.String() + " is not a tx signer",
+		})
+	})
+
+	t.Run("unlinked signer", func(t *testing.T) {
+		carol := sdk.AccAddress([]byte("carol_______________"))
+		err := checker.CheckMsg(ctx, testMsg(t, []sdk.AccAddress{alice, carol}))
+		requirePreflightError(t, err, &Error{
+			Signer:      1,
+			Instruction: -1,
+			Account:     -1,
+			Address:     carol.String(),
+			Reason:      "is not linked to any svm pubkey",
+		})
+	})
+
+	t.Run("invalid signer", func(t *testing.T) {
+		msg := testMsg(t, []sdk.AccAddress{alice})
+		msg.Signers = []string{"invalid"}
+		requirePreflightError(t, checker.CheckMsg(ctx, msg), &Error{
+			Signer:      0,
+			Instruction: -1,
+			Account:     -1,
+			Address:     "invalid",
+			Reason:      "is not a valid bech32 address",
+		})
+	})
+
+	t.Run("caller index out of accounts", func(t *testing.T) {
+		msg := testMsg(t, []sdk.AccAddress{alice})
+		msg.Instructions[0].Accounts[0].CallerIndex = uint32(len(msg.Accounts))
+		err := checker.CheckMsg(ctx, msg)
+		requirePreflightError(t, err, &Error{
+			Signer:      -1,
+			Instruction: 0,
+			Account:     0,
+			Reason:      "caller index 2 out of 2 accounts",
+		})
+	})
+}
+
+func TestCheckMsgs(t *testing.T) {
+	checker := NewChecker(testLinks())
+	ctx := context.Background()
+	send := &banktypes.MsgSend{FromAddress: alice.String(), ToAddress: bob.String()}
+
+	require.NoError(t, checker.CheckMsgs(ctx, []sdk.Msg{send, send}))
+	require.NoError(t, checker.CheckMsgs(ctx, []sdk.Msg{testMsg(t, []sdk.AccAddress{alice})}))
+
+	err := checker.CheckMsgs(ctx, []sdk.Msg{send, testMsg(t, []sdk.AccAddress{alice})})
+	require.EqualError(t, err, "svm preflight: msg 1: svm transaction must have only one MsgTransaction, tx has 2 msgs")
+}
+
+func TestErrorMessage(t *testing.T) {
+	err := &Error{Signer: -1, Instruction: 2, Account: 1, Address: "addr", Reason: "is not linked to any cosmos addr"}
+	require.EqualError(t, err, "svm preflight: instruction 2 account 1 addr is not linked to any cosmos addr")
+
+	err = &Error{Signer: 0, Instruction: -1, Account: -1, Reason: "is not linked to any svm pubkey"}
+	require.EqualError(t, err, "svm preflight: signer 0 is not linked to any svm pubkey")
+}
+
+func TestLocalLinks(t *testing.T) {
+	ctx := context.Background()
+	links := testLinks()
+
+	link, err := links.LinkByCosmosAddr(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, alicePk, solana.PublicKeyFromBytes(link.SvmAddr))
+
+	link, err = links.LinkBySvmAddr(ctx, bobPk)
+	require.NoError(t, err)
+	require.Equal(t, bob, sdk.AccAddress(link.CosmosAddr))
+
+	link, err = links.LinkBySvmAddr(ctx, stranger)
+	require.NoError(t, err)
+	require.Nil(t, link)
+
+	_, err = LocalLinks{"invalid": bobPk}.LinkBySvmAddr(ctx, bobPk)
+	require.Error(t, err)
+}
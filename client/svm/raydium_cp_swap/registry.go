@@ -0,0 +1,15 @@
+package raydium_cp_swap
+
+import (
+	"github.com/FluxNFTLabs/sdk-go/client/svm/accounts"
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+)
+
+// RegisterAccounts registers the raydium cp swap account types, programId is the
+// deployed program.
+func RegisterAccounts(r *accounts.Registry, programId solana.PublicKey) {
+	r.Register(programId, "AmmConfig", AmmConfigDiscriminator[:], func() bin.BinaryUnmarshaler { return new(AmmConfig) })
+	r.Register(programId, "ObservationState", ObservationStateDiscriminator[:], func() bin.BinaryUnmarshaler { return new(ObservationState) })
+	r.Register(programId, "PoolState", PoolStateDiscriminator[:], func() bin.BinaryUnmarshaler { return new(PoolState) })
+}
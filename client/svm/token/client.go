@@ -0,0 +1,218 @@
+package token
+
+import (
+	"context"
+	"fmt"
+
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	"github.com/FluxNFTLabs/sdk-go/client/svm"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/gagliardetto/solana-go"
+)
+
+// Client reads token accounts and sends token instructions through a svm.TxPipeline.
+// Setup helpers skip their tx when the accounts already exist.
+type Client struct {
+	pipeline *svm.TxPipeline
+	signers  []svm.TxSigner
+}
+
+// NewClient creates a token client, signers sign for the cosmos accounts linked to the
+// svm signers of the sent instructions.
+func NewClient(pipeline *svm.TxPipeline, signers ...svm.TxSigner) *Client {
+	return &Client{
+		pipeline: pipeline,
+		signers:  signers,
+	}
+}
+
+// Send sends instructions built by this package in a single tx.
+func (c *Client) Send(ctx context.Context, ixs ...solana.Instruction) (*txtypes.BroadcastTxResponse, error) {
+	res, err := c.pipeline.SendInstructions(ctx, ixs, c.signers...)
+	if err != nil {
+		return nil, err
+	}
+	if res.TxResponse.Code != 0 {
+		return res, fmt.Errorf("tx %s failed with code %d: %s", res.TxResponse.TxHash, res.TxResponse.Code, res.TxResponse.RawLog)
+	}
+	return res, nil
+}
+
+// accountData returns the data of an account, nil if it doesn't exist.
+func (c *Client) accountData(ctx context.Context, pubkey solana.PublicKey) ([]byte, error) {
+	res, err := c.pipeline.QueryClient().Account(ctx, &svmtypes.AccountRequest{Address: pubkey.String()})
+	if err != nil {
+		if svm.IsAccountNotExisted(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get account %s err: %w", pubkey, err)
+	}
+	return res.Account.Data, nil
+}
+
+// Mint returns a mint, nil if it doesn't exist.
+func (c *Client) Mint(ctx context.Context, mint solana.PublicKey) (*Mint, error) {
+	data, err := c.accountData(ctx, mint)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return DecodeMint(data)
+}
+
+// Account returns a token account, nil if it doesn't exist.
+func (c *Client) Account(ctx context.Context, account solana.PublicKey) (*Account, error) {
+	data, err := c.accountData(ctx, account)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return DecodeAccount(data)
+}
+
+// Balance returns the amount held by the associated token account of wallet, 0 when
+// the account doesn't exist.
+func (c *Client) Balance(ctx context.Context, wallet, mint, tokenProgram solana.PublicKey) (uint64, error) {
+	ata, err := FindAta(wallet, mint, tokenProgram)
+	if err != nil {
+		return 0, err
+	}
+
+	acc, err := c.Account(ctx, ata)
+	if err != nil || acc == nil {
+		return 0, err
+	}
+	return acc.Amount, nil
+}
+
+// CreateMint creates mint unless it exists, the mint account must be linked.
+func (c *Client) CreateMint(
+	ctx context.Context,
+	payer, mint, mintAuthority solana.PublicKey,
+	freezeAuthority *solana.PublicKey,
+	decimals uint8,
+	tokenProgram solana.PublicKey,
+) error {
+	if err := CheckProgram(tokenProgram); err != nil {
+		return err
+	}
+
+	existing, err := c.Mint(ctx, mint)
+	if err != nil || existing != nil {
+		return err
+	}
+
+	_, err = c.Send(ctx, NewCreateMintInstructions(payer, mint, mintAuthority, freezeAuthority, decimals, tokenProgram)...)
+	return err
+}
+
+// CreateAta creates the associated token account of wallet unless it exists, returning
+// its address.
+func (c *Client) CreateAta(ctx context.Context, payer, wallet, mint, tokenProgram solana.PublicKey) (solana.PublicKey, error) {
+	if err := CheckProgram(tokenProgram); err != nil {
+		return solana.PublicKey{}, err
+	}
+
+	ata, err := FindAta(wallet, mint, tokenProgram)
+	if err != nil {
+		return solana.PublicKey{}, err
+	}
+
+	data, err := c.accountData(ctx, ata)
+	if err != nil || data != nil {
+		return ata, err
+	}
+
+	ix, err := NewCreateAtaInstruction(payer, wallet, mint, tokenProgram)
+	if err != nil {
+		return solana.PublicKey{}, err
+	}
+	_, err = c.Send(ctx, ix)
+	return ata, err
+}
+
+// Transfer transfers amount of mint from the associated token account of owner to the
+// one of recipient, created if needed. The transfer is checked against the mint
+// decimals.
+func (c *Client) Transfer(ctx context.Context, owner, recipient, mint solana.PublicKey, amount uint64, tokenProgram solana.PublicKey) (*txtypes.BroadcastTxResponse, error) {
+	if err := CheckProgram(tokenProgram); err != nil {
+		return nil, err
+	}
+
+	m, err := c.Mint(ctx, mint)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, fmt.Errorf("mint %s doesn't exist", mint)
+	}
+
+	source, err := FindAta(owner, mint, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := FindAta(recipient, mint, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+
+	createAta, err := NewCreateAtaInstruction(owner, recipient, mint, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+	return c.Send(ctx,
+		createAta,
+		NewTransferCheckedInstruction(source, mint, destination, owner, amount, m.Decimals, tokenProgram),
+	)
+}
+
+// Approve lets delegate transfer amount from the associated token account of owner.
+func (c *Client) Approve(ctx context.Context, owner, delegate, mint solana.PublicKey, amount uint64, tokenProgram solana.PublicKey) (*txtypes.BroadcastTxResponse, error) {
+	source, err := FindAta(owner, mint, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+	return c.Send(ctx, NewApproveInstruction(source, delegate, owner, amount, tokenProgram))
+}
+
+// Revoke removes the delegate of the associated token account of owner.
+func (c *Client) Revoke(ctx context.Context, owner, mint, tokenProgram solana.PublicKey) (*txtypes.BroadcastTxResponse, error) {
+	source, err := FindAta(owner, mint, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+	return c.Send(ctx, NewRevokeInstruction(source, owner, tokenProgram))
+}
+
+// CloseAccount closes the empty associated token account of owner, reclaiming its rent.
+func (c *Client) CloseAccount(ctx context.Context, owner, mint, tokenProgram solana.PublicKey) (*txtypes.BroadcastTxResponse, error) {
+	account, err := FindAta(owner, mint, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+	return c.Send(ctx, NewCloseAccountInstruction(account, owner, owner, tokenProgram))
+}
+
+// Wrap wraps lamports of owner into the native mint.
+func (c *Client) Wrap(ctx context.Context, owner solana.PublicKey, lamports uint64, tokenProgram solana.PublicKey) (*txtypes.BroadcastTxResponse, error) {
+	if err := CheckProgram(tokenProgram); err != nil {
+		return nil, err
+	}
+
+	ixs, err := NewWrapInstructions(owner, lamports, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+	return c.Send(ctx, ixs...)
+}
+
+// Unwrap unwraps all the native tokens of owner.
+func (c *Client) Unwrap(ctx context.Context, owner, tokenProgram solana.PublicKey) (*txtypes.BroadcastTxResponse, error) {
+	if err := CheckProgram(tokenProgram); err != nil {
+		return nil, err
+	}
+
+	ix, err := NewUnwrapInstruction(owner, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+	return c.Send(ctx, ix)
+}
@@ -0,0 +1,196 @@
+// Package token builds and sends SPL token instructions on the svm plane. Every helper
+// takes the token program, svmtypes.SplTokenProgramId or svmtypes.SplToken2022ProgramId,
+// which share the base instruction set. Astromesh denoms transferred to the svm plane
+// are Token-2022 mints.
+package token
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	svmtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/svm/types"
+	"github.com/FluxNFTLabs/sdk-go/client/svm"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+)
+
+// Token instruction tags, shared by both token programs.
+const (
+	instructionTransfer        = 3
+	instructionApprove         = 4
+	instructionRevoke          = 5
+	instructionMintTo          = 7
+	instructionCloseAccount    = 9
+	instructionTransferChecked = 12
+	instructionSyncNative      = 17
+	instructionInitializeMint2 = 20
+
+	// associated token account instruction creating the account unless it exists
+	instructionCreateAtaIdempotent = 1
+)
+
+// MintSize is the size of mints without extensions.
+const MintSize = 82
+
+// CheckProgram fails on programs other than the two token programs.
+func CheckProgram(tokenProgram solana.PublicKey) error {
+	if !tokenProgram.Equals(svmtypes.SplTokenProgramId) && !tokenProgram.Equals(svmtypes.SplToken2022ProgramId) {
+		return fmt.Errorf("%s is not a token program", tokenProgram)
+	}
+	return nil
+}
+
+// NativeMint returns the wrapped native token mint of the token program.
+func NativeMint(tokenProgram solana.PublicKey) solana.PublicKey {
+	if tokenProgram.Equals(svmtypes.SplToken2022ProgramId) {
+		return svm.Sol22NativeMint
+	}
+	return solana.SolMint
+}
+
+// FindAta returns the associated token account of wallet for mint.
+func FindAta(wallet, mint, tokenProgram solana.PublicKey) (solana.PublicKey, error) {
+	return svm.FindAta(wallet, tokenProgram, mint, svmtypes.AssociatedTokenProgramId)
+}
+
+func amountData(tag byte, amount uint64) []byte {
+	return binary.LittleEndian.AppendUint64([]byte{tag}, amount)
+}
+
+// NewCreateMintInstructions creates and initializes mint, a nil freezeAuthority
+// disables freezing.
+func NewCreateMintInstructions(
+	payer, mint, mintAuthority solana.PublicKey,
+	freezeAuthority *solana.PublicKey,
+	decimals uint8,
+	tokenProgram solana.PublicKey,
+) []solana.Instruction {
+	data := append([]byte{instructionInitializeMint2, decimals}, mintAuthority[:]...)
+	if freezeAuthority != nil {
+		data = append(append(data, 1), freezeAuthority[:]...)
+	} else {
+		data = append(data, 0)
+	}
+
+	return []solana.Instruction{
+		system.NewCreateAccountInstruction(
+			svmtypes.GetRentExemptLamportAmount(MintSize), MintSize,
+			tokenProgram, payer, mint,
+		).Build(),
+		solana.NewInstruction(tokenProgram, solana.AccountMetaSlice{
+			{PublicKey: mint, IsWritable: true, IsSigner: false},
+		}, data),
+	}
+}
+
+// NewCreateAtaInstruction creates the associated token account of wallet unless it
+// exists.
+func NewCreateAtaInstruction(payer, wallet, mint, tokenProgram solana.PublicKey) (solana.Instruction, error) {
+	ata, err := FindAta(wallet, mint, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+
+	return solana.NewInstruction(svmtypes.AssociatedTokenProgramId, solana.AccountMetaSlice{
+		{PublicKey: payer, IsWritable: true, IsSigner: true},
+		{PublicKey: ata, IsWritable: true, IsSigner: false},
+		{PublicKey: wallet, IsWritable: false, IsSigner: false},
+		{PublicKey: mint, IsWritable: false, IsSigner: false},
+		{PublicKey: solana.SystemProgramID, IsWritable: false, IsSigner: false},
+		{PublicKey: tokenProgram, IsWritable: false, IsSigner: false},
+	}, []byte{instructionCreateAtaIdempotent}), nil
+}
+
+func NewMintToInstruction(mint, destination, authority solana.PublicKey, amount uint64, tokenProgram solana.PublicKey) solana.Instruction {
+	return solana.NewInstruction(tokenProgram, solana.AccountMetaSlice{
+		{PublicKey: mint, IsWritable: true, IsSigner: false},
+		{PublicKey: destination, IsWritable: true, IsSigner: false},
+		{PublicKey: authority, IsWritable: false, IsSigner: true},
+	}, amountData(instructionMintTo, amount))
+}
+
+// NewTransferInstruction transfers between token accounts. Token-2022 mints with
+// extensions such as transfer fees require NewTransferCheckedInstruction.
+func NewTransferInstruction(source, destination, owner solana.PublicKey, amount uint64, tokenProgram solana.PublicKey) solana.Instruction {
+	return solana.NewInstruction(tokenProgram, solana.AccountMetaSlice{
+		{PublicKey: source, IsWritable: true, IsSigner: false},
+		{PublicKey: destination, IsWritable: true, IsSigner: false},
+		{PublicKey: owner, IsWritable: false, IsSigner: true},
+	}, amountData(instructionTransfer, amount))
+}
+
+func NewTransferCheckedInstruction(
+	source, mint, destination, owner solana.PublicKey,
+	amount uint64, decimals uint8,
+	tokenProgram solana.PublicKey,
+) solana.Instruction {
+	return solana.NewInstruction(tokenProgram, solana.AccountMetaSlice{
+		{PublicKey: source, IsWritable: true, IsSigner: false},
+		{PublicKey: mint, IsWritable: false, IsSigner: false},
+		{PublicKey: destination, IsWritable: true, IsSigner: false},
+		{PublicKey: owner, IsWritable: false, IsSigner: true},
+	}, append(amountData(instructionTransferChecked, amount), decimals))
+}
+
+func NewApproveInstruction(source, delegate, owner solana.PublicKey, amount uint64, tokenProgram solana.PublicKey) solana.Instruction {
+	return solana.NewInstruction(tokenProgram, solana.AccountMetaSlice{
+		{PublicKey: source, IsWritable: true, IsSigner: false},
+		{PublicKey: delegate, IsWritable: false, IsSigner: false},
+		{PublicKey: owner, IsWritable: false, IsSigner: true},
+	}, amountData(instructionApprove, amount))
+}
+
+func NewRevokeInstruction(source, owner solana.PublicKey, tokenProgram solana.PublicKey) solana.Instruction {
+	return solana.NewInstruction(tokenProgram, solana.AccountMetaSlice{
+		{PublicKey: source, IsWritable: true, IsSigner: false},
+		{PublicKey: owner, IsWritable: false, IsSigner: true},
+	}, []byte{instructionRevoke})
+}
+
+// NewCloseAccountInstruction closes an empty or native token account, sending its
+// lamports to destination.
+func NewCloseAccountInstruction(account, destination, owner solana.PublicKey, tokenProgram solana.PublicKey) solana.Instruction {
+	return solana.NewInstruction(tokenProgram, solana.AccountMetaSlice{
+		{PublicKey: account, IsWritable: true, IsSigner: false},
+		{PublicKey: destination, IsWritable: true, IsSigner: false},
+		{PublicKey: owner, IsWritable: false, IsSigner: true},
+	}, []byte{instructionCloseAccount})
+}
+
+// NewSyncNativeInstruction updates the amount of a native token account to its
+// lamports.
+func NewSyncNativeInstruction(account solana.PublicKey, tokenProgram solana.PublicKey) solana.Instruction {
+	return solana.NewInstruction(tokenProgram, solana.AccountMetaSlice{
+		{PublicKey: account, IsWritable: true, IsSigner: false},
+	}, []byte{instructionSyncNative})
+}
+
+// NewWrapInstructions wraps lamports of owner into its native token account, creating
+// the account if needed.
+func NewWrapInstructions(owner solana.PublicKey, lamports uint64, tokenProgram solana.PublicKey) ([]solana.Instruction, error) {
+	mint := NativeMint(tokenProgram)
+	ata, err := FindAta(owner, mint, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+
+	createAta, err := NewCreateAtaInstruction(owner, owner, mint, tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+
+	return []solana.Instruction{
+		createAta,
+		system.NewTransferInstruction(lamports, owner, ata).Build(),
+		NewSyncNativeInstruction(ata, tokenProgram),
+	}, nil
+}
+
+// NewUnwrapInstruction unwraps the whole native token account of owner by closing it.
+func NewUnwrapInstruction(owner solana.PublicKey, tokenProgram solana.PublicKey) (solana.Instruction, error) {
+	ata, err := FindAta(owner, NativeMint(tokenProgram), tokenProgram)
+	if err != nil {
+		return nil, err
+	}
+	return NewCloseAccountInstruction(ata, owner, owner, tokenProgram), nil
+}
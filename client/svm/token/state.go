@@ -0,0 +1,262 @@
+package token
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	spl "github.com/gagliardetto/solana-go/programs/token"
+)
+
+// Token-2022 accounts keep the base layout, padded to AccountSize for mints, followed by
+// the account type and the extensions.
+const (
+	AccountSize = 165
+
+	accountTypeMint    = 1
+	accountTypeAccount = 2
+)
+
+type ExtensionType uint16
+
+const (
+	ExtensionUninitialized ExtensionType = iota
+	ExtensionTransferFeeConfig
+	ExtensionTransferFeeAmount
+	ExtensionMintCloseAuthority
+	ExtensionConfidentialTransferMint
+	ExtensionConfidentialTransferAccount
+	ExtensionDefaultAccountState
+	ExtensionImmutableOwner
+	ExtensionMemoTransfer
+	ExtensionNonTransferable
+	ExtensionInterestBearingConfig
+	ExtensionCpiGuard
+	ExtensionPermanentDelegate
+	ExtensionNonTransferableAccount
+	ExtensionTransferHook
+	ExtensionTransferHookAccount
+	ExtensionConfidentialTransferFeeConfig
+	ExtensionConfidentialTransferFeeAmount
+	ExtensionMetadataPointer
+	ExtensionTokenMetadata
+	ExtensionGroupPointer
+	ExtensionTokenGroup
+	ExtensionGroupMemberPointer
+	ExtensionTokenGroupMember
+)
+
+var extensionNames = map[ExtensionType]string{
+	ExtensionUninitialized:                 "Uninitialized",
+	ExtensionTransferFeeConfig:             "TransferFeeConfig",
+	ExtensionTransferFeeAmount:             "TransferFeeAmount",
+	ExtensionMintCloseAuthority:            "MintCloseAuthority",
+	ExtensionConfidentialTransferMint:      "ConfidentialTransferMint",
+	ExtensionConfidentialTransferAccount:   "ConfidentialTransferAccount",
+	ExtensionDefaultAccountState:           "DefaultAccountState",
+	ExtensionImmutableOwner:                "ImmutableOwner",
+	ExtensionMemoTransfer:                  "MemoTransfer",
+	ExtensionNonTransferable:               "NonTransferable",
+	ExtensionInterestBearingConfig:         "InterestBearingConfig",
+	ExtensionCpiGuard:                      "CpiGuard",
+	ExtensionPermanentDelegate:             "PermanentDelegate",
+	ExtensionNonTransferableAccount:        "NonTransferableAccount",
+	ExtensionTransferHook:                  "TransferHook",
+	ExtensionTransferHookAccount:           "TransferHookAccount",
+	ExtensionConfidentialTransferFeeConfig: "ConfidentialTransferFeeConfig",
+	ExtensionConfidentialTransferFeeAmount: "ConfidentialTransferFeeAmount",
+	ExtensionMetadataPointer:               "MetadataPointer",
+	ExtensionTokenMetadata:                 "TokenMetadata",
+	ExtensionGroupPointer:                  "GroupPointer",
+	ExtensionTokenGroup:                    "TokenGroup",
+	ExtensionGroupMemberPointer:            "GroupMemberPointer",
+	ExtensionTokenGroupMember:              "TokenGroupMember",
+}
+
+func (t ExtensionType) String() string {
+	if name, ok := extensionNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("Extension(%d)", uint16(t))
+}
+
+// Extension is a Token-2022 extension, Value is its decoded value for the types of
+// the extension structs below, nil otherwise.
+type Extension struct {
+	Type  ExtensionType
+	Data  []byte
+	Value any
+}
+
+type TransferFeeAmount struct {
+	WithheldAmount uint64
+}
+
+type MintCloseAuthority struct {
+	CloseAuthority solana.PublicKey
+}
+
+type PermanentDelegate struct {
+	Delegate solana.PublicKey
+}
+
+type MetadataPointer struct {
+	Authority       solana.PublicKey
+	MetadataAddress solana.PublicKey
+}
+
+type TokenMetadata struct {
+	UpdateAuthority    solana.PublicKey
+	Mint               solana.PublicKey
+	Name               string
+	Symbol             string
+	Uri                string
+	AdditionalMetadata []MetadataEntry
+}
+
+type MetadataEntry struct {
+	Key   string
+	Value string
+}
+
+type TransferFee struct {
+	Epoch                  uint64
+	MaximumFee             uint64
+	TransferFeeBasisPoints uint16
+}
+
+type TransferFeeConfig struct {
+	TransferFeeConfigAuthority solana.PublicKey
+	WithdrawWithheldAuthority  solana.PublicKey
+	WithheldAmount             uint64
+	OlderTransferFee           TransferFee
+	NewerTransferFee           TransferFee
+}
+
+func decodeExtensionValue(t ExtensionType, data []byte) (any, error) {
+	var v any
+	switch t {
+	case ExtensionTransferFeeConfig:
+		v = new(TransferFeeConfig)
+	case ExtensionTransferFeeAmount:
+		v = new(TransferFeeAmount)
+	case ExtensionMintCloseAuthority:
+		v = new(MintCloseAuthority)
+	case ExtensionPermanentDelegate:
+		v = new(PermanentDelegate)
+	case ExtensionMetadataPointer:
+		v = new(MetadataPointer)
+	case ExtensionTokenMetadata:
+		v = new(TokenMetadata)
+	default:
+		return nil, nil
+	}
+
+	if err := bin.NewBorshDecoder(data).Decode(v); err != nil {
+		return nil, fmt.Errorf("decode %s extension err: %w", t, err)
+	}
+	return v, nil
+}
+
+// decodeExtensions decodes the extensions after the base layout of data, expecting
+// accountType.
+func decodeExtensions(data []byte, accountType byte) ([]*Extension, error) {
+	if len(data) <= AccountSize {
+		return nil, nil
+	}
+	if data[AccountSize] != accountType {
+		return nil, fmt.Errorf("unexpected account type %d", data[AccountSize])
+	}
+
+	var extensions []*Extension
+	tlv := data[AccountSize+1:]
+	for len(tlv) >= 4 {
+		t := ExtensionType(binary.LittleEndian.Uint16(tlv))
+		length := int(binary.LittleEndian.Uint16(tlv[2:]))
+		if t == ExtensionUninitialized {
+			break
+		}
+		if len(tlv) < 4+length {
+			return nil, fmt.Errorf("%s extension has %d bytes, %d left", t, length, len(tlv)-4)
+		}
+
+		ext := &Extension{Type: t, Data: tlv[4 : 4+length]}
+		value, err := decodeExtensionValue(t, ext.Data)
+		if err != nil {
+			return nil, err
+		}
+		ext.Value = value
+		extensions = append(extensions, ext)
+		tlv = tlv[4+length:]
+	}
+	return extensions, nil
+}
+
+// Account is a token account with its Token-2022 extensions.
+type Account struct {
+	spl.Account
+	Extensions []*Extension
+}
+
+// Extension returns the extension of type t, nil if the account doesn't have it.
+func (a *Account) Extension(t ExtensionType) *Extension {
+	return findExtension(a.Extensions, t)
+}
+
+// Mint is a mint with its Token-2022 extensions.
+type Mint struct {
+	spl.Mint
+	Extensions []*Extension
+}
+
+func (m *Mint) Extension(t ExtensionType) *Extension {
+	return findExtension(m.Extensions, t)
+}
+
+func findExtension(extensions []*Extension, t ExtensionType) *Extension {
+	for _, ext := range extensions {
+		if ext.Type == t {
+			return ext
+		}
+	}
+	return nil
+}
+
+// DecodeAccount decodes token account data of either token program.
+func DecodeAccount(data []byte) (*Account, error) {
+	if len(data) < AccountSize {
+		return nil, fmt.Errorf("token account data has %d bytes, expected at least %d", len(data), AccountSize)
+	}
+
+	acc := &Account{}
+	if err := bin.NewBinDecoder(data[:AccountSize]).Decode(&acc.Account); err != nil {
+		return nil, fmt.Errorf("decode token account err: %w", err)
+	}
+
+	extensions, err := decodeExtensions(data, accountTypeAccount)
+	if err != nil {
+		return nil, err
+	}
+	acc.Extensions = extensions
+	return acc, nil
+}
+
+// DecodeMint decodes mint data of either token program.
+func DecodeMint(data []byte) (*Mint, error) {
+	if len(data) < MintSize {
+		return nil, fmt.Errorf("mint data has %d bytes, expected at least %d", len(data), MintSize)
+	}
+
+	mint := &Mint{}
+	if err := bin.NewBinDecoder(data[:MintSize]).Decode(&mint.Mint); err != nil {
+		return nil, fmt.Errorf("decode mint err: %w", err)
+	}
+
+	extensions, err := decodeExtensions(data, accountTypeMint)
+	if err != nil {
+		return nil, err
+	}
+	mint.Extensions = extensions
+	return mint, nil
+}
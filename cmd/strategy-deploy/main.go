@@ -0,0 +1,128 @@
+// Command strategy-deploy deploys strategies from declarative manifests:
+//
+//	strategy-deploy -network devnet -from deployer strategies/amm_solver.yaml
+//
+// Deploying is idempotent, a manifest whose code is already deployed by the sender only
+// updates the strategy config, or does nothing when it is unchanged. Use -dry-run to
+// validate manifests and print the planned action without broadcasting.
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	strategytypes "github.com/FluxNFTLabs/sdk-go/chain/modules/strategy/types"
+	chaintypes "github.com/FluxNFTLabs/sdk-go/chain/types"
+	chainclient "github.com/FluxNFTLabs/sdk-go/client/chain"
+	"github.com/FluxNFTLabs/sdk-go/client/common"
+	"github.com/FluxNFTLabs/sdk-go/client/strategy"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func main() {
+	networkName := flag.String("network", "local", "network to deploy to: local or devnet")
+	from := flag.String("from", "user1", "keyring key signing the deployment")
+	keyringDir := flag.String("keyring-dir", os.Getenv("HOME")+"/.fluxd", "keyring directory")
+	keyringBackend := flag.String("keyring-backend", "file", "keyring backend")
+	gasPrices := flag.String("gas-prices", "500000000lux", "gas prices")
+	dryRun := flag.Bool("dry-run", false, "validate manifests and print the planned actions only")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] manifest...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// the keyring passphrase is read from KEYRING_PASSPHRASE in CI
+	kr, err := keyring.New(
+		"fluxd",
+		*keyringBackend,
+		*keyringDir,
+		strings.NewReader(os.Getenv("KEYRING_PASSPHRASE")+"\n"),
+		chainclient.GetCryptoCodec(),
+	)
+	exitOnErr(err)
+
+	network := common.LoadNetwork(*networkName, "")
+	if network.ChainGrpcEndpoint == "" {
+		exitOnErr(fmt.Errorf("unknown network %s", *networkName))
+	}
+	cc, err := grpc.Dial(network.ChainGrpcEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	exitOnErr(err)
+	defer cc.Close()
+
+	clientCtx, senderAddress, err := chaintypes.NewClientContext(network.ChainId, *from, kr)
+	exitOnErr(err)
+	clientCtx = clientCtx.WithGRPCClient(cc)
+
+	chainClient, err := chainclient.NewChainClient(clientCtx, common.OptionGasPrices(*gasPrices))
+	exitOnErr(err)
+
+	deployer := strategy.NewDeployer(chainClient, strategytypes.NewQueryClient(cc))
+	fmt.Println("sender:", senderAddress.String())
+
+	failed := false
+	for _, path := range flag.Args() {
+		if err := deploy(deployer, path, *dryRun); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", path, err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func deploy(deployer *strategy.Deployer, path string, dryRun bool) error {
+	ctx := context.Background()
+	manifest, err := strategy.LoadManifest(path)
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		msg, existing, err := deployer.Plan(ctx, manifest)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case msg == nil:
+			fmt.Printf("%s: unchanged, strategy id %s\n", path, hex.EncodeToString(existing.Id))
+		case existing != nil:
+			fmt.Printf("%s: would update strategy id %s\n", path, msg.Id)
+		default:
+			fmt.Printf("%s: would deploy code checksum %s\n", path, hex.EncodeToString(strategy.CodeChecksum(msg.Strategy)))
+		}
+		return nil
+	}
+
+	res, err := deployer.Deploy(ctx, manifest)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s: %s, strategy id %s, code checksum %s", path, res.Action, res.Id, hex.EncodeToString(res.Checksum))
+	if res.TxHash != "" {
+		fmt.Printf(", tx %s", res.TxHash)
+	}
+	fmt.Println()
+	return nil
+}
+
+func exitOnErr(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
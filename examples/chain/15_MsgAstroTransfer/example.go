@@ -11,6 +11,7 @@ import (
 
 	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
 	chaintypes "github.com/FluxNFTLabs/sdk-go/chain/types"
+	"github.com/FluxNFTLabs/sdk-go/client/astromesh"
 	"github.com/FluxNFTLabs/sdk-go/client/common"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"google.golang.org/grpc"
@@ -72,6 +73,15 @@ func main() {
 			Amount: math.NewIntFromUint64(100),
 		},
 	}
+
+	// preview the amount arriving on the destination plane, refusing transfers losing dust
+	converter := astromesh.NewConverter(chainClient, 0)
+	conversion, err := converter.CheckTransfer(context.Background(), msg1, false)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("receiving:", conversion.Converted, conversion.Link.DstDenom)
+
 	txResp, err := chainClient.SyncBroadcastMsg(msg1)
 	if err != nil {
 		panic(err)
@@ -9,6 +9,7 @@ import (
 	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
 	chaintypes "github.com/FluxNFTLabs/sdk-go/chain/types"
 	"github.com/FluxNFTLabs/sdk-go/client/common"
+	"github.com/FluxNFTLabs/sdk-go/client/fis"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"google.golang.org/grpc"
@@ -42,29 +43,26 @@ func main() {
 	// init query client
 	astromeshClient := astromeshtypes.NewQueryClient(cc)
 
-	// query
-	keypairCodec := collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+	// query bank balance directly from the bank store
 	addr := sdk.MustAccAddressFromBech32("lux1jcltmuhplrdcwp7stlr4hlhlhgd4htqhu86cqx")
-	key, _ := collections.EncodeKeyWithPrefix(banktypes.BalancesPrefix, keypairCodec, collections.Join(addr, "lux"))
-	fisReq := &astromeshtypes.FISQueryRequest{Instructions: []*astromeshtypes.FISQueryInstruction{
-		{
-			Plane:   astromeshtypes.Plane_COSMOS,
-			Action:  astromeshtypes.QueryAction_COSMOS_KVSTORE,
-			Address: []byte{},
-			Input: [][]byte{
-				[]byte("bank"),
-				key,
-			},
-		},
-	}}
-	res, err := astromeshClient.FISQuery(context.Background(), fisReq)
+	balanceIx, err := fis.KVStoreQuery(
+		"bank",
+		banktypes.BalancesPrefix,
+		collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
+		collections.Join(addr, "lux"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	res, err := fis.Query(context.Background(), astromeshClient, balanceIx)
 	if err != nil {
-		fmt.Println(res, err)
+		panic(err)
 	}
 
-	for _, ixRes := range res.InstructionResponses {
-		for _, obj := range ixRes.Output {
-			fmt.Println(string(obj))
-		}
+	balance, found, err := fis.DecodeKVStore(res.InstructionResponses[0], sdk.IntValue)
+	if err != nil {
+		panic(err)
 	}
+	fmt.Println("lux balance:", balance, "found:", found)
 }
@@ -3,10 +3,10 @@ package main
 import (
 	sdkmath "cosmossdk.io/math"
 	"fmt"
-	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
 	chaintypes "github.com/FluxNFTLabs/sdk-go/chain/types"
 	chainclient "github.com/FluxNFTLabs/sdk-go/client/chain"
 	"github.com/FluxNFTLabs/sdk-go/client/common"
+	"github.com/FluxNFTLabs/sdk-go/client/fis"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -63,19 +63,25 @@ func main() {
 			Denom: "lux", Amount: sdkmath.NewInt(1000000000000000000)}, // 1 LUX
 		},
 	}
-	sendMsgBz, _ := clientCtx.Codec.MarshalInterfaceJSON(sendMsg)
 
-	FISMsg := &astromeshtypes.MsgFISTransaction{
-		Sender: senderAddress.String(),
-		Instructions: []*astromeshtypes.FISInstruction{
-			{
-				Plane:   astromeshtypes.Plane_COSMOS,
-				Action:  astromeshtypes.TxAction_COSMOS_INVOKE,
-				Address: []byte{},
-				Msg:     sendMsgBz,
-			},
-		},
+	// build the fis tx, more instructions on any plane can be chained and execute atomically
+	FISMsg, gas, err := fis.NewTxBuilder(clientCtx, senderAddress, chainClient).
+		CosmosInvoke(sendMsg).
+		EstimateGas(chainClient, 1.5)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("estimated gas:", gas)
+
+	// preview what every instruction does before signing
+	simRes, err := fis.Simulate(chainClient, clientCtx, FISMsg)
+	if err != nil {
+		panic(err)
+	}
+	for _, ix := range simRes.Instructions {
+		fmt.Println("instruction", ix.Index, ix.Instruction.Plane, ix.Instruction.Action, "output:", len(ix.Output), "bytes")
 	}
+	fmt.Println("balance updates:", len(simRes.BalanceUpdates))
 
 	//AsyncBroadcastMsg, SyncBroadcastMsg, QueueBroadcastMsg
 	res, err := chainClient.SyncBroadcastMsg(FISMsg)
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	chaintypes "github.com/FluxNFTLabs/sdk-go/chain/types"
+	"github.com/FluxNFTLabs/sdk-go/client/astromesh"
+	"github.com/FluxNFTLabs/sdk-go/client/common"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	chainclient "github.com/FluxNFTLabs/sdk-go/client/chain"
+)
+
+func main() {
+	network := common.LoadNetwork("local", "")
+	kr, err := keyring.New(
+		"fluxd",
+		"file",
+		os.Getenv("HOME")+"/.fluxd",
+		strings.NewReader("12345678\n"),
+		chainclient.GetCryptoCodec(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// init grpc connection
+	cc, err := grpc.Dial(network.ChainGrpcEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(err)
+	}
+
+	// init client ctx
+	clientCtx, senderAddress, err := chaintypes.NewClientContext(
+		network.ChainId,
+		"user1",
+		kr,
+	)
+	if err != nil {
+		panic(err)
+	}
+	clientCtx = clientCtx.WithGRPCClient(cc)
+
+	// init chain client
+	chainClient, err := chainclient.NewChainClient(
+		clientCtx,
+		common.OptionGasPrices("500000000lux"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// create the denom and mint the initial supply to the sender
+	denoms := astromesh.NewDenomManager(chainClient)
+	denom, err := denoms.Create(context.Background(), astromesh.DenomSpec{
+		Subdenom:    "sad",
+		Name:        "Sad Token",
+		Symbol:      "SAD",
+		Description: "Sad meme token",
+		Display:     "sad",
+		Decimals:    6,
+	}, senderAddress.String(), &astromeshtypes.InitialMint{
+		Address: senderAddress.String(),
+		Amount:  math.NewIntFromUint64(1_000_000_000_000),
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("denom:", denom.Denom)
+	fmt.Println("tx hash:", denom.TxHash)
+
+	if contract, ok := denom.EvmContract(); ok {
+		fmt.Println("evm contract:", contract.Hex())
+	}
+	if mint, ok := denom.SvmMint(); ok {
+		fmt.Println("svm mint:", mint.String(), "authority:", astromeshtypes.SvmMintAuthority.String())
+	}
+	if contract, ok := denom.WasmContract(); ok {
+		fmt.Println("wasm contract:", contract)
+	}
+
+	// move part of the supply to the sender's svm account and back
+	txResp, err := denoms.Charge(astromeshtypes.Plane_SVM, sdk.NewCoin(denom.Denom, math.NewIntFromUint64(1_000_000)))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("charge resp:", txResp.TxResponse.TxHash)
+
+	isLinked, svmAccount, err := chainClient.GetSVMAccountLink(context.Background(), senderAddress)
+	if err != nil {
+		panic(err)
+	}
+	if isLinked {
+		ata, _ := denom.SvmAta(svmAccount)
+		fmt.Println("svm token account:", ata.String())
+	}
+
+	txResp, err = denoms.Drain(astromeshtypes.Plane_SVM)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println("drain resp:", txResp.TxResponse.TxHash)
+}
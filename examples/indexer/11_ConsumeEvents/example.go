@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/eventstream/types"
+	astromeshtypes "github.com/FluxNFTLabs/sdk-go/chain/modules/astromesh/types"
+	"github.com/FluxNFTLabs/sdk-go/client/common"
+	"github.com/FluxNFTLabs/sdk-go/client/eventstream"
+)
+
+func main() {
+	network := common.LoadNetwork("local", "")
+	cc, err := grpc.Dial(network.ChainGrpcEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(err)
+	}
+	defer cc.Close()
+
+	// last processed height is kept in this file, restarting resumes from the next height
+	checkpoint, err := eventstream.NewFileCheckpoint(".events_checkpoint")
+	if err != nil {
+		panic(err)
+	}
+	defer checkpoint.Close()
+
+	// decode events into typed structs and dispatch them per type
+	router := eventstream.NewEventRouter(nil)
+	eventstream.OnEvent(router, func(ctx context.Context, block *eventstream.DecodedBlock, ev *astromeshtypes.BalanceUpdateEvent) error {
+		for _, upd := range ev.Upd {
+			fmt.Println("balance update:", ev.Plane, upd.Denom, len(upd.Balances), "accounts")
+		}
+		return nil
+	})
+	router.OnBlock(func(ctx context.Context, block *eventstream.DecodedBlock) error {
+		fmt.Println("===================", block.Height, block.Time, len(block.Events), "events")
+		if block.Tm.Block != nil {
+			fmt.Println("block txs:", len(block.Tm.Block.Block.Txs))
+		}
+		return nil
+	})
+
+	consumer, err := eventstream.NewConsumer(
+		types.NewQueryClient(cc),
+		checkpoint,
+		router.Handle,
+		eventstream.OptionModules("astromesh"),
+		eventstream.OptionTmQueries(eventstream.TmQueryBlock),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := consumer.Run(context.Background()); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FluxNFTLabs/sdk-go/chain/indexer/fnft"
+	"github.com/FluxNFTLabs/sdk-go/client/common"
+	"github.com/FluxNFTLabs/sdk-go/client/indexer"
+)
+
+func main() {
+	network := common.LoadNetwork("local", "")
+	client, err := indexer.NewIndexerClient(network)
+	if err != nil {
+		panic(err)
+	}
+	defer client.Close()
+
+	classes, err := client.Fnft().GetClasses(context.Background(), &fnft.ClassesRequest{})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(classes)
+
+	stream, err := indexer.NewStream(context.Background(), func(ctx context.Context) (indexer.Recver[*fnft.HoldersResponse], error) {
+		return client.Fnft().StreamHolders(ctx, &fnft.HoldersRequest{ClassId: "series"})
+	}, indexer.OptionOnReconnect(func(err error) {
+		fmt.Println("holders stream disconnected, reconnecting:", err)
+	}))
+	if err != nil {
+		panic(err)
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(res)
+	}
+}